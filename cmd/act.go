@@ -0,0 +1,315 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	act_flag "github.com/Rfluid/insta-tools/src/act/flag"
+	act_service "github.com/Rfluid/insta-tools/src/act/service"
+	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	filter_flag "github.com/Rfluid/insta-tools/src/filter/flag"
+	filter_service "github.com/Rfluid/insta-tools/src/filter/service"
+	input_service "github.com/Rfluid/insta-tools/src/input/service"
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	me_service "github.com/Rfluid/insta-tools/src/me/service"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	progress_service "github.com/Rfluid/insta-tools/src/progress/service"
+	ratelimit_flag "github.com/Rfluid/insta-tools/src/ratelimit/flag"
+	thread_flag "github.com/Rfluid/insta-tools/src/thread/flag"
+	user_service "github.com/Rfluid/insta-tools/src/user/service"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// actRequestsPerMinute is the default --rate-limit of act commands
+const actRequestsPerMinute = 20
+
+// actCmd represents the act command
+var actCmd = &cobra.Command{
+	Use:   "act",
+	Short: "Change the relationships of the logged-in account in bulk",
+	Long: `This command performs actions as the account of the session cookies.
+
+Every action is a dry run by default: the accounts are resolved and the planned actions
+are listed, but nothing is changed. Pass --dry-run=false to perform them, after an
+interactive confirmation (or --yes). Accounts listed more than once are acted on once.
+Actions run one at a time, and each performed action is appended to the --action-log
+file (NDJSON) with its result.
+
+Every API request, including the lookups of usernames and follow requests, goes through
+--rate-limit, which defaults to 20 requests per minute for act commands.
+
+--filter selects the accounts to act on: the user records of --from-file for unfollow and
+remove-follower, and the pending requests for act requests. The results are written without it.`,
+}
+
+// actUsersCmd builds a subcommand of act that performs action on a list of accounts.
+func actUsersCmd(use string, short string, long string, action act_service.Action) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [user...]",
+		Short: short,
+		Long:  long,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if act_flag.FromFile != "" {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.MinimumNArgs(1)(cmd, args)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			actRateLimit(cmd)
+
+			var (
+				users   = args
+				records []map[string]interface{}
+			)
+			if act_flag.FromFile != "" {
+				var err error
+				records, users, err = input_service.ReadUserRecords(act_flag.FromFile)
+				if err != nil {
+					pterm.DefaultLogger.Error(err.Error())
+					os.Exit(1)
+				}
+			}
+			if filter_flag.Expression != "" && records == nil {
+				// Plain names have no fields to select on; failing is safer than acting on all of them
+				pterm.DefaultLogger.Error(fmt.Sprintf("--filter needs user records: pass an export of users (JSON or NDJSON) with --from-file to act %s", use))
+				os.Exit(1)
+			}
+			if records != nil {
+				// Keep only the accounts matching --filter, before anything is done to them
+				matched, err := filter_service.Apply(records)
+				if err != nil {
+					pterm.DefaultLogger.Error(err.Error())
+					os.Exit(1)
+				}
+				users, err = input_service.Users(matched)
+				if err != nil {
+					pterm.DefaultLogger.Error(err.Error())
+					os.Exit(1)
+				}
+				log_service.LogConditionally(
+					pterm.DefaultLogger.Info,
+					fmt.Sprintf("%d of the accounts of %s match", len(users), act_flag.FromFile),
+				)
+			}
+
+			// Parse cookies
+			cookies := cookie_service.ParseCookies()
+
+			// Resolve the usernames into IDs
+			accounts, failures := user_service.ResolveIDs(users, cookies, thread_flag.APIThreads, 0)
+			writeLookupErrors(failures, len(users))
+
+			if !act(action, accounts, cookies) || len(failures) > 0 {
+				os.Exit(1)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&act_flag.FromFile, "from-file", "", "Read accounts from a file (\"-\" for stdin): one per line, or user records exported as JSON or NDJSON")
+	return cmd
+}
+
+// actRequestsCmd represents the act requests command
+var actRequestsCmd = &cobra.Command{
+	Use:   "requests",
+	Short: "Accept or reject pending follow requests in bulk",
+	Long: `This command accepts or rejects the pending follow requests of the logged-in account.
+
+Every pending request is fetched, then only the ones matching all the given selections are
+acted on:
+  --verified          requests from verified accounts
+  --has-profile-pic   requests from accounts with a profile picture
+  --from-file         requests from the accounts listed in a file
+  --filter            requests for which a jq expression over the requesting user is true
+
+The matches are previewed as a dry run unless --dry-run=false is given.`,
+}
+
+// actRequestsActionCmd builds a subcommand of act requests that performs action on the selected requests.
+func actRequestsActionCmd(use string, short string, action act_service.Action) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long: fmt.Sprintf(`This command %ss the pending follow requests matching the selections of act requests.
+
+Example:
+  insta-tools act requests %s --verified --cookies "<your_cookies>"
+  insta-tools act requests %s --has-profile-pic --filter '.full_name != ""' --dry-run=false --cookies "<your_cookies>"`, use, use, use),
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			actRateLimit(cmd)
+
+			selection := act_service.Selection{
+				Verified:      act_flag.Verified,
+				HasProfilePic: act_flag.HasProfilePic,
+			}
+			if act_flag.FromFile != "" {
+				var err error
+				selection.Listed, err = input_service.ReadUsers(act_flag.FromFile)
+				if err != nil {
+					pterm.DefaultLogger.Error(err.Error())
+					os.Exit(1)
+				}
+			}
+
+			// Parse cookies
+			cookies := cookie_service.ParseCookies()
+
+			// Fetch every pending request
+			pending, _, err := me_service.GetAll(me_service.ListRequests, cookies, pagination_service.Options{Threads: 1})
+			if err != nil {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching follow requests: %s", err))
+				os.Exit(1)
+			}
+
+			// Keep only the requests matching --filter and the selections
+			pending, err = filter_service.Apply(pending)
+			if err != nil {
+				pterm.DefaultLogger.Error(err.Error())
+				os.Exit(1)
+			}
+			accounts := selection.Select(pending)
+			log_service.LogConditionally(
+				pterm.DefaultLogger.Info,
+				fmt.Sprintf("%d of the pending follow requests match", len(accounts)),
+			)
+
+			if !act(action, accounts, cookies) {
+				os.Exit(1)
+			}
+		},
+	}
+	return cmd
+}
+
+// act previews action on accounts and, unless it is a dry run, performs it after confirmation,
+// then writes the results. It returns false when an action failed.
+func act(action act_service.Action, accounts []user_service.Account, cookies map[string]string) bool {
+	// Acting twice on an account would only fail or repeat the log line
+	unique := act_service.Unique(accounts)
+	if duplicates := len(accounts) - len(unique); duplicates > 0 {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Skipping %d accounts listed more than once", duplicates),
+		)
+	}
+	accounts = unique
+
+	if len(accounts) == 0 {
+		pterm.DefaultLogger.Warn("No accounts to act on")
+		return true
+	}
+
+	var results []act_service.Result
+	switch {
+	case act_flag.DryRun:
+		results = act_service.Preview(action, accounts)
+		pterm.DefaultLogger.WithWriter(os.Stderr).Info(
+			fmt.Sprintf("Dry run: %s would be performed on %d accounts. Pass --dry-run=false to perform it", action.Name, len(accounts)),
+		)
+	case !confirm(fmt.Sprintf("Perform %s on %d accounts?", action.Name, len(accounts))):
+		pterm.DefaultLogger.Warn("Aborted")
+		return true
+	default:
+		bar := progress_service.Start(fmt.Sprintf("Performing %s", action.Name), len(accounts))
+		var err error
+		results, err = act_service.Run(action, accounts, cookies, act_flag.ActionLog, func(act_service.Result) {
+			bar.Page(1)
+		})
+		bar.Stop()
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			if len(results) == 0 {
+				os.Exit(1)
+			}
+		}
+	}
+
+	ok := true
+	for _, result := range results {
+		if result.Status == act_service.StatusFailed {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error performing %s on %s: %s", result.Action, result.UserID, result.Error))
+			ok = false
+		}
+	}
+
+	records, err := output_service.ToRecords(results)
+	if err != nil {
+		pterm.DefaultLogger.Error(err.Error())
+		os.Exit(1)
+	}
+	renderRecords(records, act_service.ResultColumns)
+	return ok
+}
+
+// actRateLimit makes act commands default to actRequestsPerMinute unless --rate-limit was given,
+// since actions are the requests Instagram is most likely to block.
+func actRateLimit(cmd *cobra.Command) {
+	if !cmd.Flags().Changed("rate-limit") {
+		ratelimit_flag.RequestsPerMinute = actRequestsPerMinute
+	}
+}
+
+// confirm asks the user to confirm on the terminal, unless --yes was given.
+// Without a terminal to ask on, it refuses.
+func confirm(question string) bool {
+	if act_flag.Yes {
+		return true
+	}
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		pterm.DefaultLogger.Error("Cannot ask for confirmation without a terminal. Pass --yes to confirm")
+		os.Exit(1)
+	}
+
+	fmt.Fprintf(os.Stderr, "%s [y/N] ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+func init() {
+	rootCmd.AddCommand(actCmd)
+
+	actCmd.AddCommand(actUsersCmd(
+		"unfollow",
+		"Unfollow accounts",
+		`This command unfollows the given accounts, e.g. the accounts that do not follow back.
+
+Accounts are given as usernames, userIDs or profile URLs, on the command line or with --from-file.
+
+Example:
+  insta-tools query --db graph.db "SELECT user_pk AS pk FROM ..." -o cleanup.json
+  insta-tools act unfollow --from-file cleanup.json --cookies "<your_cookies>"
+  insta-tools act unfollow --from-file cleanup.json --dry-run=false --cookies "<your_cookies>"`,
+		act_service.ActionUnfollow,
+	))
+	actCmd.AddCommand(actUsersCmd(
+		"remove-follower",
+		"Remove followers",
+		`This command removes the given accounts from your followers.
+
+Accounts are given as usernames, userIDs or profile URLs, on the command line or with --from-file.
+
+Example:
+  insta-tools act remove-follower spam_account_1 spam_account_2 --dry-run=false --cookies "<your_cookies>"`,
+		act_service.ActionRemoveFollower,
+	))
+
+	actCmd.AddCommand(actRequestsCmd)
+	actRequestsCmd.AddCommand(actRequestsActionCmd("accept", "Accept pending follow requests", act_service.ActionAccept))
+	actRequestsCmd.AddCommand(actRequestsActionCmd("reject", "Reject pending follow requests", act_service.ActionReject))
+	actRequestsCmd.PersistentFlags().BoolVar(&act_flag.Verified, "verified", false, "Only act on requests from verified accounts")
+	actRequestsCmd.PersistentFlags().BoolVar(&act_flag.HasProfilePic, "has-profile-pic", false, "Only act on requests from accounts with a profile picture")
+	actRequestsCmd.PersistentFlags().StringVar(&act_flag.FromFile, "from-file", "", "Only act on requests from the accounts listed in a file (\"-\" for stdin): one per line, or user records exported as JSON or NDJSON")
+
+	actCmd.PersistentFlags().BoolVar(&act_flag.DryRun, "dry-run", true, "Only list the actions that would be performed; pass --dry-run=false to perform them")
+	actCmd.PersistentFlags().BoolVarP(&act_flag.Yes, "yes", "y", false, "Perform the actions without asking for confirmation")
+	actCmd.PersistentFlags().StringVar(&act_flag.ActionLog, "action-log", "insta-tools-actions.ndjson", "File every performed action is appended to, with its result (NDJSON)")
+}
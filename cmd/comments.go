@@ -0,0 +1,80 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	comments_flag "github.com/Rfluid/insta-tools/src/comments/flag"
+	comments_service "github.com/Rfluid/insta-tools/src/comments/service"
+	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	parser_service "github.com/Rfluid/insta-tools/src/parser/service"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// commentsCmd represents the comments command
+var commentsCmd = &cobra.Command{
+	Use:   "comments [shortcode|mediaID] [cursor]",
+	Short: "Retrieve the comments of an Instagram post",
+	Long: `This command fetches the comments of a post.
+
+Arguments:
+1. The shortcode (the part after /p/ in a post URL) or the media ID of the post.
+2. An optional cursor to paginate requests, as printed by a previous run.
+
+Each comment has its author, text, timestamp, like count and reply count. With --replies,
+every reply is fetched as well and listed right after its parent, with parent_id set.
+
+Example:
+  insta-tools comments CxYz123AbC --all --replies --format csv -o comments.csv --cookies "<your_cookies>"`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse arguments
+		mediaID, err := parser_service.MediaID(args[0])
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		cursor := ""
+		if len(args) == 2 {
+			cursor = args[1]
+		}
+
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching comments for mediaID: %s and initial cursor: %s", mediaID, cursor),
+		)
+
+		// Fetch comments using pagination
+		comments, summary, reqErr := comments_service.GetAll(mediaID, cookies, pagination_service.FlagOptions(cursor), comments_flag.Replies)
+		if reqErr != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching comments: %s. Only partial results available", reqErr))
+		}
+
+		records, err := output_service.ToRecords(comments)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		writePaginated(records, columnsTable(comments_service.TableColumns), summary)
+		if reqErr != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(commentsCmd)
+
+	addPaginationFlags(commentsCmd, "comments")
+	commentsCmd.Flags().BoolVar(&comments_flag.Replies, "replies", false, "Fetch every reply of each comment")
+}
@@ -0,0 +1,182 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	dm_service "github.com/Rfluid/insta-tools/src/dm/service"
+	filter_service "github.com/Rfluid/insta-tools/src/filter/service"
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	output_flag "github.com/Rfluid/insta-tools/src/output/flag"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// dmCmd represents the dm command
+var dmCmd = &cobra.Command{
+	Use:   "dm",
+	Short: "Archive the direct messages of the logged-in account",
+	Long: `This command lists and exports the direct message threads of the account of the session cookies,
+e.g. to archive a business inbox.
+
+Media URLs in exports expire after a while, like in media exports.`,
+}
+
+// dmThreadsCmd represents the dm threads command
+var dmThreadsCmd = &cobra.Command{
+	Use:   "threads [cursor]",
+	Short: "List your direct message threads",
+	Long: `This command fetches the direct message threads of the logged-in account, from the most
+recently active one, with their participants and last message.
+
+Arguments:
+1. An optional cursor to paginate requests.
+
+Example:
+  insta-tools dm threads --all -o threads.json --cookies "<your_cookies>"`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse arguments
+		cursor := ""
+		if len(args) == 1 {
+			cursor = args[0]
+		}
+
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching direct message threads with initial cursor: %s", cursor),
+		)
+
+		threads, summary, reqErr := dm_service.GetAllThreads(cookies, pagination_service.FlagOptions(cursor))
+		if reqErr != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching threads: %s. Only partial results available", reqErr))
+		}
+
+		records, err := output_service.ToRecords(threads)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		writePaginated(records, columnsTable(dm_service.ThreadColumns), summary)
+		if reqErr != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+// dmExportCmd represents the dm export command
+var dmExportCmd = &cobra.Command{
+	Use:   "export [thread-id] [cursor]",
+	Short: "Export the messages of a direct message thread",
+	Long: `This command fetches the messages of a direct message thread, given its ID as listed by
+dm threads, and outputs them in chronological order with their sender, timestamp, text, links and media URLs.
+
+With --format html, the thread is written as a single page that can be opened in a browser.
+
+Arguments:
+1. A thread ID.
+2. An optional cursor to paginate requests, fetching older messages.
+
+Example:
+  insta-tools dm export 340282366841710300949128123456789012345 --all -o thread.json --cookies "<your_cookies>"
+  insta-tools dm export 340282366841710300949128123456789012345 --all --format html -o thread.html --cookies "<your_cookies>"`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse arguments
+		threadID := args[0]
+		cursor := ""
+		if len(args) == 2 {
+			cursor = args[1]
+		}
+
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching messages of thread %s with initial cursor: %s", threadID, cursor),
+		)
+
+		thread, messages, summary, reqErr := dm_service.GetAllMessages(threadID, cookies, pagination_service.FlagOptions(cursor))
+		if reqErr != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching messages: %s. Only partial results available", reqErr))
+		}
+
+		records, err := output_service.ToRecords(messages)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		if output_service.Format() == output_flag.FormatHTML {
+			writeThreadHTML(thread, messages, records, summary)
+		} else {
+			writePaginated(records, columnsTable(dm_service.MessageColumns), summary)
+		}
+		if reqErr != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+// writeThreadHTML renders the messages matching --filter as an HTML page, then prints or writes it.
+// records are the messages converted to records, in the same order.
+func writeThreadHTML(
+	thread dm_service.Thread,
+	messages []dm_service.Message,
+	records []map[string]interface{},
+	summary pagination_service.Summary,
+) {
+	log_service.LogConditionally(pterm.DefaultLogger.Info, summary.String())
+	if summary.NextMaxID != "" {
+		// Printed on stderr so it does not mix with the results
+		pterm.DefaultLogger.WithWriter(os.Stderr).Info(
+			fmt.Sprintf("Older messages are available. Pass %s as the cursor argument to continue", summary.NextMaxID),
+		)
+	}
+
+	// Keep only the messages matching --filter
+	var matched []dm_service.Message
+	for i, record := range records {
+		ok, err := filter_service.Match(record)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		if ok {
+			matched = append(matched, messages[i])
+		}
+	}
+
+	result, err := dm_service.RenderHTML(thread, matched)
+	if err != nil {
+		pterm.DefaultLogger.Error(fmt.Sprintf("Failed to render output: %s", err))
+		os.Exit(1)
+	}
+
+	// Print or save output
+	output_service.PrintConditionally(result)
+	if err := output_service.WriteConditionally(result); err != nil {
+		pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+		os.Exit(1)
+	}
+
+	writeSummary(summary)
+}
+
+func init() {
+	rootCmd.AddCommand(dmCmd)
+	dmCmd.AddCommand(dmThreadsCmd)
+	dmCmd.AddCommand(dmExportCmd)
+
+	addPaginationFlags(dmThreadsCmd, "threads")
+	addPaginationFlags(dmExportCmd, "messages")
+}
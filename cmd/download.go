@@ -0,0 +1,212 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	download_flag "github.com/Rfluid/insta-tools/src/download/flag"
+	download_service "github.com/Rfluid/insta-tools/src/download/service"
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	media_service "github.com/Rfluid/insta-tools/src/media/service"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	parser_service "github.com/Rfluid/insta-tools/src/parser/service"
+	thread_flag "github.com/Rfluid/insta-tools/src/thread/flag"
+	user_service "github.com/Rfluid/insta-tools/src/user/service"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// downloadCmd represents the download command
+var downloadCmd = &cobra.Command{
+	Use:   "download",
+	Short: "Download profile pictures and media to disk",
+	Long: `This command saves profile pictures and post media into --dir, with one directory per account:
+
+  <dir>/<user>/<date>_<shortcode>_<n>.<ext>   (n numbers the items of a carousel)
+  <dir>/<user>/<date>_profile.<ext>
+
+Files that already exist are skipped, and every account directory keeps a SHA256SUMS manifest
+that can be verified with "sha256sum -c SHA256SUMS".`,
+}
+
+// downloadProfilePicCmd represents the download profile-pic command
+var downloadProfilePicCmd = &cobra.Command{
+	Use:   "profile-pic [user...]",
+	Short: "Download the HD profile picture of Instagram accounts",
+	Long: `This command downloads the HD profile picture of the given usernames or userIDs.
+
+Example:
+  insta-tools download profile-pic zuck --dir ./archive --cookies "<your_cookies>"`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		var (
+			files  []download_service.File
+			failed bool
+		)
+		for _, user := range args {
+			log_service.LogConditionally(pterm.DefaultLogger.Info, fmt.Sprintf("Fetching user for %s", user))
+
+			userID, err := user_service.ResolveID(user, cookies)
+			if err != nil {
+				pterm.DefaultLogger.Error(err.Error())
+				failed = true
+				continue
+			}
+			profile, err := user_service.GetByID(userID, cookies)
+			if err != nil {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching user %s: %s", user, err))
+				failed = true
+				continue
+			}
+
+			records := output_service.ProfileRecords(profile)
+			if len(records) == 0 {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching user %s: %s", user, user_service.ErrNotFound))
+				failed = true
+				continue
+			}
+			username, _ := records[0]["username"].(string)
+			pictureURL, _ := records[0]["profile_pic_url_hd"].(string)
+			if pictureURL == "" {
+				pictureURL, _ = records[0]["profile_pic_url"].(string)
+			}
+			files = append(files, download_service.ProfilePictureFile(download_flag.Directory, username, pictureURL))
+		}
+
+		if !writeDownloads(files) || failed {
+			os.Exit(1)
+		}
+	},
+}
+
+// downloadMediaCmd represents the download media command
+var downloadMediaCmd = &cobra.Command{
+	Use:   "media [post...]",
+	Short: "Download the images and videos of posts",
+	Long: `This command downloads the images and videos of posts, given their URLs,
+shortcodes (the part after /p/ in a post URL) or media IDs, or a media export with --from-export.
+
+Media URLs in exports expire after a while; use --refresh to fetch fresh URLs before downloading.
+
+Example:
+  insta-tools download media CxYz123AbC --dir ./archive --cookies "<your_cookies>"
+  insta-tools media zuck --all -o zuck-posts.json --cookies "<your_cookies>"
+  insta-tools download media --from-export zuck-posts.json --refresh --dir ./archive --cookies "<your_cookies>"`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if download_flag.FromExport != "" {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.MinimumNArgs(1)(cmd, args)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		var (
+			media  []media_service.Media
+			failed bool
+		)
+		if download_flag.FromExport != "" {
+			exported, err := media_service.ReadExport(download_flag.FromExport)
+			if err != nil {
+				pterm.DefaultLogger.Error(err.Error())
+				os.Exit(1)
+			}
+			if !download_flag.Refresh {
+				media = exported
+			} else {
+				for _, post := range exported {
+					args = append(args, post.Shortcode)
+				}
+			}
+		}
+
+		// Fetch the posts given by URL, shortcode or media ID
+		for _, post := range args {
+			mediaID, err := parser_service.MediaID(post)
+			if err != nil {
+				pterm.DefaultLogger.Error(err.Error())
+				failed = true
+				continue
+			}
+
+			log_service.LogConditionally(pterm.DefaultLogger.Info, fmt.Sprintf("Fetching media %s", post))
+			info, err := media_service.GetInfo(mediaID, cookies)
+			if err != nil {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching media %s: %s", post, err))
+				failed = true
+				continue
+			}
+			media = append(media, info)
+		}
+
+		files := download_service.MediaFiles(download_flag.Directory, media)
+		if !writeDownloads(files) || failed {
+			os.Exit(1)
+		}
+	},
+}
+
+// writeDownloads downloads files, updates the checksum manifests and writes the per-file results.
+// It returns false when a download failed.
+func writeDownloads(files []download_service.File) bool {
+	log_service.LogConditionally(
+		pterm.DefaultLogger.Info,
+		fmt.Sprintf("Downloading %d files with %d threads", len(files), thread_flag.APIThreads),
+	)
+
+	results := download_service.DownloadAll(files, thread_flag.APIThreads, download_flag.SleepTime)
+	if err := download_service.WriteManifests(results); err != nil {
+		pterm.DefaultLogger.Error(err.Error())
+		os.Exit(1)
+	}
+
+	ok := true
+	for _, result := range results {
+		if result.Status == download_service.StatusFailed {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error downloading %s: %s", result.Path, result.Error))
+			ok = false
+		}
+	}
+
+	records, err := output_service.ToRecords(results)
+	if err != nil {
+		pterm.DefaultLogger.Error(err.Error())
+		os.Exit(1)
+	}
+
+	// Render the results as a table, JSON, NDJSON or CSV
+	result, err := output_service.Render(records, records, func() (string, error) {
+		return output_service.RecordsTable(download_service.ResultColumns, records)
+	})
+	if err != nil {
+		pterm.DefaultLogger.Error(fmt.Sprintf("Failed to render output: %s", err))
+		os.Exit(1)
+	}
+
+	output_service.PrintConditionally(result)
+	if err := output_service.WriteConditionally(result); err != nil {
+		pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+		os.Exit(1)
+	}
+
+	return ok
+}
+
+func init() {
+	rootCmd.AddCommand(downloadCmd)
+	downloadCmd.AddCommand(downloadProfilePicCmd)
+	downloadCmd.AddCommand(downloadMediaCmd)
+
+	downloadCmd.PersistentFlags().StringVar(&download_flag.Directory, "dir", ".", "Directory where files are saved, with one sub-directory per account")
+	downloadCmd.PersistentFlags().IntVar(&download_flag.SleepTime, "sleep", 0, "Seconds to wait between downloads of each thread")
+	downloadMediaCmd.Flags().StringVar(&download_flag.FromExport, "from-export", "", "Download the posts of a media export (JSON or NDJSON written by the media command)")
+	downloadMediaCmd.Flags().BoolVar(&download_flag.Refresh, "refresh", false, "Fetch fresh media URLs for the posts of --from-export before downloading")
+}
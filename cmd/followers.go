@@ -10,11 +10,18 @@ import (
 	"strconv"
 
 	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	filter_service "github.com/Rfluid/insta-tools/src/filter/service"
 	followers_flag "github.com/Rfluid/insta-tools/src/followers/flag"
 	followers_service "github.com/Rfluid/insta-tools/src/followers/service"
 	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	output_flag "github.com/Rfluid/insta-tools/src/output/flag"
 	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	parser_service "github.com/Rfluid/insta-tools/src/parser/service"
+	progress_service "github.com/Rfluid/insta-tools/src/progress/service"
+	sqlite_service "github.com/Rfluid/insta-tools/src/sqlite/service"
 	thread_flag "github.com/Rfluid/insta-tools/src/thread/flag"
+	user_service "github.com/Rfluid/insta-tools/src/user/service"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 )
@@ -47,30 +54,102 @@ It requires:
 		cookies := cookie_service.ParseCookies()
 
 		// Check if retrieving all followers
-		if followers_flag.RetrieveAll {
+		// Limits imply pagination
+		if followers_flag.RetrieveAll || followers_flag.MaxItems > 0 || followers_flag.MaxPages > 0 {
 			log_service.LogConditionally(
 				pterm.DefaultLogger.Info,
 				fmt.Sprintf("Fetching ALL followers for userID: %s with count: %d and initial maxID: %s", userID, count, maxID),
 			)
 
+			// Look up the profile's follower count (by username when given, by ID otherwise),
+			// used as the progress bar total and to check coverage
+			expected := 0
+			var (
+				profile    map[string]interface{}
+				profileErr error
+			)
+			if followers_flag.Username != "" {
+				var username string
+				username, profileErr = parser_service.Username(followers_flag.Username)
+				if profileErr == nil {
+					profile, profileErr = user_service.Get(username, cookies)
+				}
+			} else {
+				profile, profileErr = user_service.GetByID(userID, cookies)
+			}
+			if profileErr != nil {
+				pterm.DefaultLogger.Warn(fmt.Sprintf("Could not fetch profile of %s to check coverage: %s", userID, profileErr))
+			} else if total, ok := user_service.EdgeCount(profile, "edge_followed_by"); ok {
+				expected = total
+			}
+
 			// Fetch all followers using pagination
-			followers, reqErr := followers_service.GetAll(userID, cookies, count, maxID, thread_flag.APIThreads, followers_flag.SleepTime)
+			bar := progress_service.Start("Fetching followers", expected)
+			followers, summary, reqErr := followers_service.GetAll(userID, cookies, count, pagination_service.Options{
+				InitialMaxID:   maxID,
+				Threads:        thread_flag.APIThreads,
+				SleepTime:      followers_flag.SleepTime,
+				KeepDuplicates: followers_flag.KeepDuplicates,
+				MaxItems:       followers_flag.MaxItems,
+				MaxPages:       followers_flag.MaxPages,
+				OnPage:         bar.Page,
+			})
+			bar.Stop()
 			if reqErr != nil {
 				pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching all followers: %s. Only partial results available", reqErr))
 			}
 
-			// Convert to JSON
-			resultJSON, err := json.MarshalIndent(followers, "", "  ")
+			summary.ItemsExpected = expected
+			log_service.LogConditionally(pterm.DefaultLogger.Info, summary.String())
+			if summary.NextMaxID != "" {
+				// Printed on stderr so it does not mix with the results
+				pterm.DefaultLogger.WithWriter(os.Stderr).Info(
+					fmt.Sprintf("Stopped at the requested limit. Pass %s as maxID to continue", summary.NextMaxID),
+				)
+			}
+
+			// Keep only the followers matching --filter
+			followers, err = filter_service.Apply(followers)
 			if err != nil {
-				pterm.DefaultLogger.Error(fmt.Sprintf("Failed to convert data to JSON: %s", err))
+				pterm.DefaultLogger.Error(err.Error())
 				os.Exit(1)
 			}
 
-			// Print or save output
-			output_service.PrintConditionally(string(resultJSON))
-			if err := output_service.WriteConditionally(string(resultJSON)); err != nil {
-				pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
-				os.Exit(1)
+			if output_service.Format() == output_flag.FormatSQLite {
+				// Store into the SQLite database given by -o
+				if err := sqlite_service.SaveRelationships(output_flag.OutputPath, userID, sqlite_service.DirectionFollowers, followers); err != nil {
+					pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+					os.Exit(1)
+				}
+			} else {
+				// Render as a table or JSON
+				result, err := output_service.Render(followers, followers, func() (string, error) {
+					return output_service.UsersTable(followers, summary.String())
+				})
+				if err != nil {
+					pterm.DefaultLogger.Error(fmt.Sprintf("Failed to render output: %s", err))
+					os.Exit(1)
+				}
+
+				// Print or save output
+				output_service.PrintConditionally(result)
+				if err := output_service.WriteConditionally(result); err != nil {
+					pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+					os.Exit(1)
+				}
+			}
+
+			// Write the run summary alongside the output
+			if followers_flag.Summary {
+				summaryJSON, err := json.MarshalIndent(summary, "", "  ")
+				if err != nil {
+					pterm.DefaultLogger.Error(fmt.Sprintf("Failed to convert summary to JSON: %s", err))
+					os.Exit(1)
+				}
+				if err := output_service.WriteAlongside(".summary.json", string(summaryJSON)); err != nil {
+					pterm.DefaultLogger.Error(fmt.Sprintf("Error writing summary: %s", err))
+					os.Exit(1)
+				}
 			}
 			if reqErr != nil {
 				os.Exit(1)
@@ -85,23 +164,45 @@ It requires:
 			fmt.Sprintf("Fetching followers for userID: %s with count: %d and maxID: %s", userID, count, maxID),
 		)
 
-		data, reqErr := followers_service.Get(userID, cookies, count, maxID)
+		cursor, skip := pagination_service.SplitCursor(maxID)
+		data, reqErr := followers_service.Get(userID, cookies, count, cursor)
 		if reqErr != nil {
 			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching followers: %s", reqErr))
 		}
 
-		// Convert map[string]interface{} to JSON
-		resultJSON, err := json.MarshalIndent(data, "", "  ")
-		if err != nil {
-			pterm.DefaultLogger.Error(fmt.Sprintf("Failed to convert data to JSON: %s", err))
-			os.Exit(1)
+		// Drop the users returned by the run that stopped in the middle of this page
+		if users, ok := data["users"].([]interface{}); ok && skip > 0 {
+			data["users"] = users[min(skip, len(users)):]
 		}
 
-		output_service.PrintConditionally(string(resultJSON))
-		if err := output_service.WriteConditionally(string(resultJSON)); err != nil {
-			pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+		// Keep only the followers matching --filter
+		if err := filter_service.ApplyPage(data, "users"); err != nil {
+			pterm.DefaultLogger.Error(err.Error())
 			os.Exit(1)
 		}
+
+		if output_service.Format() == output_flag.FormatSQLite {
+			// Store into the SQLite database given by -o
+			if err := sqlite_service.SaveRelationships(output_flag.OutputPath, userID, sqlite_service.DirectionFollowers, output_service.PageRecords(data)); err != nil {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+				os.Exit(1)
+			}
+		} else {
+			// Render the page as a table or JSON
+			result, err := output_service.Render(data, output_service.PageRecords(data), func() (string, error) {
+				return output_service.PageTable(data)
+			})
+			if err != nil {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Failed to render output: %s", err))
+				os.Exit(1)
+			}
+
+			output_service.PrintConditionally(result)
+			if err := output_service.WriteConditionally(result); err != nil {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+				os.Exit(1)
+			}
+		}
 		if reqErr != nil {
 			os.Exit(1)
 		}
@@ -122,4 +223,9 @@ func init() {
 	// followersCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 	followersCmd.Flags().BoolVarP(&followers_flag.RetrieveAll, "all", "a", false, "Retrieve all followers using pagination")
 	followersCmd.Flags().IntVar(&followers_flag.SleepTime, "sleep", 0, "Seconds to wait between API requests when using --all")
+	followersCmd.Flags().StringVar(&followers_flag.Username, "username", "", "Username or profile URL of the target account, used to look up the profile's count instead of the userID when using --all")
+	followersCmd.Flags().BoolVar(&followers_flag.Summary, "summary", false, "Write a run summary (pages fetched/failed, coverage) alongside the output when using --all")
+	followersCmd.Flags().BoolVar(&followers_flag.KeepDuplicates, "keep-duplicates", false, "Keep users repeated across pages instead of de-duplicating them by pk (for debugging)")
+	followersCmd.Flags().IntVar(&followers_flag.MaxItems, "max-items", 0, "Stop paginating once this many followers were collected (implies --all)")
+	followersCmd.Flags().IntVar(&followers_flag.MaxPages, "max-pages", 0, "Stop paginating once this many pages were fetched (implies --all)")
 }
@@ -10,11 +10,18 @@ import (
 	"strconv"
 
 	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	filter_service "github.com/Rfluid/insta-tools/src/filter/service"
 	following_flag "github.com/Rfluid/insta-tools/src/following/flag"
 	following_service "github.com/Rfluid/insta-tools/src/following/service"
 	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	output_flag "github.com/Rfluid/insta-tools/src/output/flag"
 	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	parser_service "github.com/Rfluid/insta-tools/src/parser/service"
+	progress_service "github.com/Rfluid/insta-tools/src/progress/service"
+	sqlite_service "github.com/Rfluid/insta-tools/src/sqlite/service"
 	thread_flag "github.com/Rfluid/insta-tools/src/thread/flag"
+	user_service "github.com/Rfluid/insta-tools/src/user/service"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 )
@@ -47,30 +54,102 @@ Arguments:
 		cookies := cookie_service.ParseCookies()
 
 		// Check if retrieving all following
-		if following_flag.RetrieveAll {
+		// Limits imply pagination
+		if following_flag.RetrieveAll || following_flag.MaxItems > 0 || following_flag.MaxPages > 0 {
 			log_service.LogConditionally(
 				pterm.DefaultLogger.Info,
 				fmt.Sprintf("Fetching ALL following for userID: %s with count: %d and initial maxID: %s", userID, count, maxID),
 			)
 
+			// Look up the profile's following count (by username when given, by ID otherwise),
+			// used as the progress bar total and to check coverage
+			expected := 0
+			var (
+				profile    map[string]interface{}
+				profileErr error
+			)
+			if following_flag.Username != "" {
+				var username string
+				username, profileErr = parser_service.Username(following_flag.Username)
+				if profileErr == nil {
+					profile, profileErr = user_service.Get(username, cookies)
+				}
+			} else {
+				profile, profileErr = user_service.GetByID(userID, cookies)
+			}
+			if profileErr != nil {
+				pterm.DefaultLogger.Warn(fmt.Sprintf("Could not fetch profile of %s to check coverage: %s", userID, profileErr))
+			} else if total, ok := user_service.EdgeCount(profile, "edge_follow"); ok {
+				expected = total
+			}
+
 			// Fetch all following using pagination
-			following, reqErr := following_service.GetAll(userID, cookies, count, maxID, thread_flag.APIThreads, following_flag.SleepTime)
+			bar := progress_service.Start("Fetching following", expected)
+			following, summary, reqErr := following_service.GetAll(userID, cookies, count, pagination_service.Options{
+				InitialMaxID:   maxID,
+				Threads:        thread_flag.APIThreads,
+				SleepTime:      following_flag.SleepTime,
+				KeepDuplicates: following_flag.KeepDuplicates,
+				MaxItems:       following_flag.MaxItems,
+				MaxPages:       following_flag.MaxPages,
+				OnPage:         bar.Page,
+			})
+			bar.Stop()
 			if reqErr != nil {
 				pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching all following: %s. Only partial results available", reqErr))
 			}
 
-			// Convert to JSON
-			resultJSON, err := json.MarshalIndent(following, "", "  ")
+			summary.ItemsExpected = expected
+			log_service.LogConditionally(pterm.DefaultLogger.Info, summary.String())
+			if summary.NextMaxID != "" {
+				// Printed on stderr so it does not mix with the results
+				pterm.DefaultLogger.WithWriter(os.Stderr).Info(
+					fmt.Sprintf("Stopped at the requested limit. Pass %s as maxID to continue", summary.NextMaxID),
+				)
+			}
+
+			// Keep only the following matching --filter
+			following, err = filter_service.Apply(following)
 			if err != nil {
-				pterm.DefaultLogger.Error(fmt.Sprintf("Failed to convert data to JSON: %s", err))
+				pterm.DefaultLogger.Error(err.Error())
 				os.Exit(1)
 			}
 
-			// Print or save output
-			output_service.PrintConditionally(string(resultJSON))
-			if err := output_service.WriteConditionally(string(resultJSON)); err != nil {
-				pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
-				os.Exit(1)
+			if output_service.Format() == output_flag.FormatSQLite {
+				// Store into the SQLite database given by -o
+				if err := sqlite_service.SaveRelationships(output_flag.OutputPath, userID, sqlite_service.DirectionFollowing, following); err != nil {
+					pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+					os.Exit(1)
+				}
+			} else {
+				// Render as a table or JSON
+				result, err := output_service.Render(following, following, func() (string, error) {
+					return output_service.UsersTable(following, summary.String())
+				})
+				if err != nil {
+					pterm.DefaultLogger.Error(fmt.Sprintf("Failed to render output: %s", err))
+					os.Exit(1)
+				}
+
+				// Print or save output
+				output_service.PrintConditionally(result)
+				if err := output_service.WriteConditionally(result); err != nil {
+					pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+					os.Exit(1)
+				}
+			}
+
+			// Write the run summary alongside the output
+			if following_flag.Summary {
+				summaryJSON, err := json.MarshalIndent(summary, "", "  ")
+				if err != nil {
+					pterm.DefaultLogger.Error(fmt.Sprintf("Failed to convert summary to JSON: %s", err))
+					os.Exit(1)
+				}
+				if err := output_service.WriteAlongside(".summary.json", string(summaryJSON)); err != nil {
+					pterm.DefaultLogger.Error(fmt.Sprintf("Error writing summary: %s", err))
+					os.Exit(1)
+				}
 			}
 			if reqErr != nil {
 				os.Exit(1)
@@ -85,23 +164,45 @@ Arguments:
 			fmt.Sprintf("Fetching following for userID: %s with count: %d and maxID: %s", userID, count, maxID),
 		)
 
-		data, reqErr := following_service.Get(userID, cookies, count, maxID)
+		cursor, skip := pagination_service.SplitCursor(maxID)
+		data, reqErr := following_service.Get(userID, cookies, count, cursor)
 		if reqErr != nil {
 			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching following: %s", reqErr))
 		}
 
-		// Convert map[string]interface{} to JSON
-		resultJSON, err := json.MarshalIndent(data, "", "  ")
-		if err != nil {
-			pterm.DefaultLogger.Error(fmt.Sprintf("Failed to convert data to JSON: %s", err))
-			os.Exit(1)
+		// Drop the users returned by the run that stopped in the middle of this page
+		if users, ok := data["users"].([]interface{}); ok && skip > 0 {
+			data["users"] = users[min(skip, len(users)):]
 		}
 
-		output_service.PrintConditionally(string(resultJSON))
-		if err := output_service.WriteConditionally(string(resultJSON)); err != nil {
-			pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+		// Keep only the following matching --filter
+		if err := filter_service.ApplyPage(data, "users"); err != nil {
+			pterm.DefaultLogger.Error(err.Error())
 			os.Exit(1)
 		}
+
+		if output_service.Format() == output_flag.FormatSQLite {
+			// Store into the SQLite database given by -o
+			if err := sqlite_service.SaveRelationships(output_flag.OutputPath, userID, sqlite_service.DirectionFollowing, output_service.PageRecords(data)); err != nil {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+				os.Exit(1)
+			}
+		} else {
+			// Render the page as a table or JSON
+			result, err := output_service.Render(data, output_service.PageRecords(data), func() (string, error) {
+				return output_service.PageTable(data)
+			})
+			if err != nil {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Failed to render output: %s", err))
+				os.Exit(1)
+			}
+
+			output_service.PrintConditionally(result)
+			if err := output_service.WriteConditionally(result); err != nil {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+				os.Exit(1)
+			}
+		}
 		if reqErr != nil {
 			os.Exit(1)
 		}
@@ -122,4 +223,9 @@ func init() {
 	// followingCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 	followingCmd.Flags().BoolVarP(&following_flag.RetrieveAll, "all", "a", false, "Retrieve all followings using pagination")
 	followingCmd.Flags().IntVar(&following_flag.SleepTime, "sleep", 1, "Seconds to wait between API requests when using --all")
+	followingCmd.Flags().StringVar(&following_flag.Username, "username", "", "Username or profile URL of the target account, used to look up the profile's count instead of the userID when using --all")
+	followingCmd.Flags().BoolVar(&following_flag.Summary, "summary", false, "Write a run summary (pages fetched/failed, coverage) alongside the output when using --all")
+	followingCmd.Flags().BoolVar(&following_flag.KeepDuplicates, "keep-duplicates", false, "Keep users repeated across pages instead of de-duplicating them by pk (for debugging)")
+	followingCmd.Flags().IntVar(&following_flag.MaxItems, "max-items", 0, "Stop paginating once this many following were collected (implies --all)")
+	followingCmd.Flags().IntVar(&following_flag.MaxPages, "max-pages", 0, "Stop paginating once this many pages were fetched (implies --all)")
 }
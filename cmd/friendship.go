@@ -0,0 +1,102 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	friendship_flag "github.com/Rfluid/insta-tools/src/friendship/flag"
+	friendship_service "github.com/Rfluid/insta-tools/src/friendship/service"
+	input_service "github.com/Rfluid/insta-tools/src/input/service"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	thread_flag "github.com/Rfluid/insta-tools/src/thread/flag"
+	user_service "github.com/Rfluid/insta-tools/src/user/service"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// friendshipCmd represents the friendship command
+var friendshipCmd = &cobra.Command{
+	Use:   "friendship [user...]",
+	Short: "Check the relationship of the logged-in account with other accounts",
+	Long: `This command reports, for the account of the session cookies, the relationship with each
+given account: whether you follow them, you requested to follow them, they requested to
+follow you, or you restricted them.
+
+Accounts are given as usernames, userIDs or profile URLs, on the command line or one per
+line with --from-file (or stdin with "-"). Usernames are first resolved into IDs; pass
+IDs to save those requests.
+
+Statuses come from the bulk friendship endpoint, a single request per 50 accounts. It does
+not report whether they follow you, or whether you blocked or muted them: --detailed fetches
+those too, with one more request per account. Fields that were not reported are null.
+
+Example:
+  insta-tools friendship zuck 314216 --detailed --cookies "<your_cookies>"
+  insta-tools friendship --from-file accounts.txt --format csv -o status.csv --cookies "<your_cookies>"`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if friendship_flag.FromFile != "" {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.MinimumNArgs(1)(cmd, args)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		users := args
+		if friendship_flag.FromFile != "" {
+			var err error
+			users, err = input_service.ReadLines(friendship_flag.FromFile)
+			if err != nil {
+				pterm.DefaultLogger.Error(err.Error())
+				os.Exit(1)
+			}
+		}
+
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		// Resolve the usernames into IDs
+		accounts, failures := user_service.ResolveIDs(users, cookies, thread_flag.APIThreads, friendship_flag.SleepTime)
+		ids := make([]string, len(accounts))
+		for i, account := range accounts {
+			ids[i] = account.ID
+		}
+
+		statuses, reqErr := friendship_service.Lookup(ids, cookies, friendship_flag.Detailed, friendship_flag.SleepTime)
+		if reqErr != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching friendship status: %s. Only partial results available", reqErr))
+		}
+
+		// Name the accounts that were given by username
+		usernames := make(map[string]string, len(accounts))
+		for _, account := range accounts {
+			usernames[account.ID] = account.Username
+		}
+		for i := range statuses {
+			statuses[i].Username = usernames[statuses[i].UserID]
+		}
+
+		records, err := output_service.ToRecords(statuses)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		writeRecords(records, friendship_service.TableColumns)
+
+		// Report the accounts that could not be resolved separately
+		writeLookupErrors(failures, len(users))
+		if len(failures) > 0 || reqErr != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(friendshipCmd)
+
+	friendshipCmd.Flags().StringVar(&friendship_flag.FromFile, "from-file", "", "Read accounts from a file, one per line (\"-\" for stdin)")
+	friendshipCmd.Flags().BoolVar(&friendship_flag.Detailed, "detailed", false, "Also fetch whether they follow you and whether you blocked or muted them, with one request per account")
+	friendshipCmd.Flags().IntVar(&friendship_flag.SleepTime, "sleep", 0, "Seconds to wait between API requests")
+}
@@ -0,0 +1,147 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	media_flag "github.com/Rfluid/insta-tools/src/media/flag"
+	media_service "github.com/Rfluid/insta-tools/src/media/service"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	parser_service "github.com/Rfluid/insta-tools/src/parser/service"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// hashtagCmd represents the hashtag command
+var hashtagCmd = &cobra.Command{
+	Use:   "hashtag [tag] [maxID]",
+	Short: "Retrieve the top or recent posts of a hashtag",
+	Long: `This command fetches the posts of a hashtag feed.
+
+Arguments:
+1. The hashtag, with or without #, or its URL.
+2. An optional maxID to paginate requests.
+
+Posts have the same fields as in the media command. --tab selects the top (default)
+or recent posts.
+
+Example:
+  insta-tools hashtag coffee --tab recent --max-items 500 --format csv -o coffee.csv --cookies "<your_cookies>"`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse arguments
+		tag, err := parser_service.Hashtag(args[0])
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		maxID := ""
+		if len(args) == 2 {
+			maxID = args[1]
+		}
+		validateTab()
+
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching %s media for #%s and initial maxID: %s", media_flag.Tab, tag, maxID),
+		)
+
+		// Fetch media using pagination
+		media, summary, reqErr := media_service.GetAllHashtag(tag, media_flag.Tab, cookies, pagination_service.FlagOptions(maxID))
+		if reqErr != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching hashtag media: %s. Only partial results available", reqErr))
+		}
+
+		records, err := output_service.ToRecords(media)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		writePaginated(records, columnsTable(media_service.TaggedColumns), summary)
+		if reqErr != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+// locationCmd represents the location command
+var locationCmd = &cobra.Command{
+	Use:   "location [locationID] [maxID]",
+	Short: "Retrieve the top or recent posts of a location",
+	Long: `This command fetches the posts of a location feed.
+
+Arguments:
+1. The numeric location ID (the number after /explore/locations/ in its URL), or its URL.
+2. An optional maxID to paginate requests.
+
+Posts have the same fields as in the media command. --tab selects the top (default)
+or recent posts.
+
+Example:
+  insta-tools location 213385402 --tab recent --max-items 500 --cookies "<your_cookies>"`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse arguments
+		locationID, err := parser_service.LocationID(args[0])
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		maxID := ""
+		if len(args) == 2 {
+			maxID = args[1]
+		}
+		validateTab()
+
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching %s media for location %s and initial maxID: %s", media_flag.Tab, locationID, maxID),
+		)
+
+		// Fetch media using pagination
+		media, summary, reqErr := media_service.GetAllLocation(locationID, media_flag.Tab, cookies, pagination_service.FlagOptions(maxID))
+		if reqErr != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching location media: %s. Only partial results available", reqErr))
+		}
+
+		records, err := output_service.ToRecords(media)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		writePaginated(records, columnsTable(media_service.TaggedColumns), summary)
+		if reqErr != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+// validateTab exits when --tab is not a tab of the hashtag and location feeds.
+func validateTab() {
+	if media_flag.Tab != media_service.TabTop && media_flag.Tab != media_service.TabRecent {
+		pterm.DefaultLogger.Error(fmt.Sprintf("Invalid --tab %q. Must be %s or %s", media_flag.Tab, media_service.TabTop, media_service.TabRecent))
+		os.Exit(1)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(hashtagCmd)
+	rootCmd.AddCommand(locationCmd)
+
+	for _, cmd := range []*cobra.Command{hashtagCmd, locationCmd} {
+		addPaginationFlags(cmd, "media")
+		cmd.Flags().StringVar(&media_flag.Tab, "tab", media_service.TabTop, "Posts to fetch: top or recent")
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	filter_service "github.com/Rfluid/insta-tools/src/filter/service"
+	likers_service "github.com/Rfluid/insta-tools/src/likers/service"
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	media_service "github.com/Rfluid/insta-tools/src/media/service"
+	output_flag "github.com/Rfluid/insta-tools/src/output/flag"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	pagination_flag "github.com/Rfluid/insta-tools/src/pagination/flag"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	parser_service "github.com/Rfluid/insta-tools/src/parser/service"
+	progress_service "github.com/Rfluid/insta-tools/src/progress/service"
+	sqlite_service "github.com/Rfluid/insta-tools/src/sqlite/service"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// likersCmd represents the likers command
+var likersCmd = &cobra.Command{
+	Use:   "likers [shortcode|mediaID] [maxID]",
+	Short: "Retrieve the accounts that liked an Instagram post",
+	Long: `This command fetches the accounts that liked a post.
+
+Arguments:
+1. The shortcode (the part after /p/ in a post URL) or the media ID of the post.
+2. An optional maxID to paginate requests.
+
+Users have the same fields as in followers and following, so the exports can be
+cross-referenced. With --format sqlite, likers are stored in the likes table of the
+same database as the follow lists.
+
+Example:
+  insta-tools likers CxYz123AbC --all --format sqlite -o graph.db --cookies "<your_cookies>"`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse arguments
+		mediaID, err := parser_service.MediaID(args[0])
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		maxID := ""
+		if len(args) == 2 {
+			maxID = args[1]
+		}
+
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		// Look up the post's like count for the progress bar and coverage when fetching everything
+		expected := 0
+		if pagination_flag.RetrieveAll {
+			post, err := media_service.GetInfo(mediaID, cookies)
+			if err != nil {
+				pterm.DefaultLogger.Warn(fmt.Sprintf("Could not fetch post %s to check coverage: %s", mediaID, err))
+			} else {
+				expected = post.LikeCount
+			}
+		}
+
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching likers for mediaID: %s and initial maxID: %s", mediaID, maxID),
+		)
+
+		// Fetch likers using pagination
+		opts := pagination_service.FlagOptions(maxID)
+		bar := progress_service.Start("Fetching likers", expected)
+		opts.OnPage = bar.Page
+		likers, summary, reqErr := likers_service.GetAll(mediaID, cookies, opts)
+		bar.Stop()
+		if reqErr != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching likers: %s. Only partial results available", reqErr))
+		}
+		summary.ItemsExpected = expected
+
+		if output_service.Format() == output_flag.FormatSQLite {
+			log_service.LogConditionally(pterm.DefaultLogger.Info, summary.String())
+
+			// Keep only the likers matching --filter
+			likers, err = filter_service.Apply(likers)
+			if err != nil {
+				pterm.DefaultLogger.Error(err.Error())
+				os.Exit(1)
+			}
+
+			// Store into the SQLite database given by -o
+			if err := sqlite_service.SaveLikes(output_flag.OutputPath, mediaID, likers); err != nil {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+				os.Exit(1)
+			}
+			writeSummary(summary)
+		} else {
+			writePaginated(likers, usersTable, summary)
+		}
+		if reqErr != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(likersCmd)
+
+	addPaginationFlags(likersCmd, "likers")
+}
@@ -0,0 +1,206 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	download_flag "github.com/Rfluid/insta-tools/src/download/flag"
+	download_service "github.com/Rfluid/insta-tools/src/download/service"
+	filter_service "github.com/Rfluid/insta-tools/src/filter/service"
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	me_flag "github.com/Rfluid/insta-tools/src/me/flag"
+	me_service "github.com/Rfluid/insta-tools/src/me/service"
+	media_service "github.com/Rfluid/insta-tools/src/media/service"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// meCmd represents the me command
+var meCmd = &cobra.Command{
+	Use:   "me",
+	Short: "Retrieve lists only visible to the logged-in account",
+	Long: `This command fetches lists of the account of the session cookies that only its owner can see,
+such as pending follow requests or blocked accounts, e.g. for account hygiene audits, and
+its own activity: the posts it saved or liked.
+
+Users have the same fields as in followers and following, and posts as in media.`,
+}
+
+// meSavedCmd represents the me saved command
+var meSavedCmd = &cobra.Command{
+	Use:   "saved [maxID]",
+	Short: "List the posts you saved",
+	Long: `This command fetches the posts saved by the logged-in account, with the names of the
+collections each one was saved to. With --download, their media are downloaded like with
+download media instead.
+
+Example:
+  insta-tools me saved --all -o saved.json --cookies "<your_cookies>"
+  insta-tools me saved --all --filter 'any(.collections[]; . == "Recipes")' --download --dir ./archive --cookies "<your_cookies>"`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse arguments
+		maxID := ""
+		if len(args) == 1 {
+			maxID = args[0]
+		}
+
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching saved media with initial maxID: %s", maxID),
+		)
+
+		// Fetch saved media using pagination
+		saved, summary, reqErr := me_service.GetAllSaved(cookies, meMediaCount, pagination_service.FlagOptions(maxID))
+		if reqErr != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching saved media: %s. Only partial results available", reqErr))
+		}
+
+		media := make([]media_service.Media, len(saved))
+		for i, post := range saved {
+			media[i] = post.Media
+		}
+		records, err := output_service.ToRecords(saved)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		if !writeMeMedia(media, records, me_service.SavedColumns, summary) || reqErr != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+// meLikedCmd represents the me liked command
+var meLikedCmd = &cobra.Command{
+	Use:   "liked [maxID]",
+	Short: "List the posts you liked",
+	Long: `This command fetches the posts liked by the logged-in account. With --download, their media
+are downloaded like with download media instead.
+
+Example:
+  insta-tools me liked --all --format csv -o liked.csv --cookies "<your_cookies>"`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse arguments
+		maxID := ""
+		if len(args) == 1 {
+			maxID = args[0]
+		}
+
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching liked media with initial maxID: %s", maxID),
+		)
+
+		// Fetch liked media using pagination
+		media, summary, reqErr := media_service.GetAllLiked(cookies, meMediaCount, pagination_service.FlagOptions(maxID))
+		if reqErr != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching liked media: %s. Only partial results available", reqErr))
+		}
+
+		records, err := output_service.ToRecords(media)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		if !writeMeMedia(media, records, media_service.TaggedColumns, summary) || reqErr != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+// Number of posts requested per page of the saved and liked feeds
+const meMediaCount = 12
+
+// writeMeMedia writes the saved or liked posts, or downloads the ones matching --filter with --download.
+// records are the records of media, in the same order. It returns false when a download failed.
+func writeMeMedia(media []media_service.Media, records []map[string]interface{}, columns []string, summary pagination_service.Summary) bool {
+	if !me_flag.Download {
+		writePaginated(records, columnsTable(columns), summary)
+		return true
+	}
+
+	log_service.LogConditionally(pterm.DefaultLogger.Info, summary.String())
+
+	// Keep only the posts matching --filter
+	var selected []media_service.Media
+	for i, record := range records {
+		match, err := filter_service.Match(record)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		if match {
+			selected = append(selected, media[i])
+		}
+	}
+
+	return writeDownloads(download_service.MediaFiles(download_flag.Directory, selected))
+}
+
+// meListCmd builds a subcommand of me that pages through list.
+func meListCmd(use string, short string, list me_service.List) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [maxID]",
+		Short: short,
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			// Parse arguments
+			maxID := ""
+			if len(args) == 1 {
+				maxID = args[0]
+			}
+
+			// Parse cookies
+			cookies := cookie_service.ParseCookies()
+
+			log_service.LogConditionally(
+				pterm.DefaultLogger.Info,
+				fmt.Sprintf("Fetching %s with initial maxID: %s", list.Name, maxID),
+			)
+
+			// Fetch the list using pagination
+			users, summary, reqErr := me_service.GetAll(list, cookies, pagination_service.FlagOptions(maxID))
+			if reqErr != nil {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching %s: %s. Only partial results available", list.Name, reqErr))
+			}
+
+			writePaginated(users, usersTable, summary)
+			if reqErr != nil {
+				os.Exit(1)
+			}
+		},
+	}
+	addPaginationFlags(cmd, list.Name)
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(meCmd)
+
+	meCmd.AddCommand(meListCmd("requests", "List the accounts that requested to follow you", me_service.ListRequests))
+	meCmd.AddCommand(meListCmd("sent-requests", "List the accounts you requested to follow", me_service.ListSentRequests))
+	meCmd.AddCommand(meListCmd("close-friends", "List your close friends", me_service.ListCloseFriends))
+	meCmd.AddCommand(meListCmd("blocked", "List the accounts you blocked", me_service.ListBlocked))
+	meCmd.AddCommand(meSavedCmd)
+	meCmd.AddCommand(meLikedCmd)
+
+	for _, cmd := range []*cobra.Command{meSavedCmd, meLikedCmd} {
+		addPaginationFlags(cmd, "media")
+		cmd.Flags().BoolVar(&me_flag.Download, "download", false, "Download the media of the posts into --dir instead of listing them")
+		cmd.Flags().StringVar(&download_flag.Directory, "dir", ".", "Directory where files are saved, with one sub-directory per account")
+	}
+}
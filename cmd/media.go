@@ -0,0 +1,108 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	media_service "github.com/Rfluid/insta-tools/src/media/service"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	pagination_flag "github.com/Rfluid/insta-tools/src/pagination/flag"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	progress_service "github.com/Rfluid/insta-tools/src/progress/service"
+	user_service "github.com/Rfluid/insta-tools/src/user/service"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// mediaCmd represents the media command
+var mediaCmd = &cobra.Command{
+	Use:   "media [user] [count] [maxID]",
+	Short: "Retrieve the posts of an Instagram account",
+	Long: `This command fetches the posts (feed) of a given Instagram account.
+
+Arguments:
+1. A username or userID.
+2. An optional batch count (number of posts per request, default 12).
+3. An optional maxID to paginate requests.
+
+Each post has its id, shortcode, type (image, video or carousel), caption, timestamp,
+like/comment counts, media URL and, for carousels, its children.
+
+Example:
+  insta-tools media zuck --all --cookies "<your_cookies>"`,
+	Args: cobra.RangeArgs(1, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse arguments
+		count := 12
+		if len(args) >= 2 {
+			var err error
+			count, err = strconv.Atoi(args[1])
+			if err != nil {
+				pterm.DefaultLogger.Error("Invalid count argument. Must be an integer.")
+				os.Exit(1)
+			}
+		}
+		maxID := ""
+		if len(args) == 3 {
+			maxID = args[2]
+		}
+
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		userID, err := user_service.ResolveID(args[0], cookies)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+
+		// Look up the profile's post count for the progress bar and coverage when fetching everything
+		expected := 0
+		if pagination_flag.RetrieveAll {
+			profile, err := user_service.GetByID(userID, cookies)
+			if err != nil {
+				pterm.DefaultLogger.Warn(fmt.Sprintf("Could not fetch profile of %s to check coverage: %s", userID, err))
+			} else if total, ok := user_service.EdgeCount(profile, "edge_owner_to_timeline_media"); ok {
+				expected = total
+			}
+		}
+
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching media for userID: %s with count: %d and initial maxID: %s", userID, count, maxID),
+		)
+
+		// Fetch media using pagination
+		opts := pagination_service.FlagOptions(maxID)
+		bar := progress_service.Start("Fetching media", expected)
+		opts.OnPage = bar.Page
+		media, summary, reqErr := media_service.GetAll(userID, cookies, count, opts)
+		bar.Stop()
+		if reqErr != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching media: %s. Only partial results available", reqErr))
+		}
+		summary.ItemsExpected = expected
+
+		records, err := output_service.ToRecords(media)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		writePaginated(records, columnsTable(media_service.TableColumns), summary)
+		if reqErr != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(mediaCmd)
+
+	addPaginationFlags(mediaCmd, "media")
+}
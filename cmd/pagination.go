@@ -0,0 +1,108 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	filter_service "github.com/Rfluid/insta-tools/src/filter/service"
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	output_flag "github.com/Rfluid/insta-tools/src/output/flag"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	pagination_flag "github.com/Rfluid/insta-tools/src/pagination/flag"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// columnsTable renders records as a table with the given columns, for use with writePaginated.
+func columnsTable(columns []string) func(records []map[string]interface{}) (string, error) {
+	return func(records []map[string]interface{}) (string, error) {
+		return output_service.RecordsTable(columns, records)
+	}
+}
+
+// usersTable renders users like the follow lists, for use with writePaginated.
+func usersTable(records []map[string]interface{}) (string, error) {
+	return output_service.UsersTable(records)
+}
+
+// addPaginationFlags registers the shared pagination flags on a command that pages through noun.
+func addPaginationFlags(cmd *cobra.Command, noun string) {
+	cmd.Flags().BoolVarP(&pagination_flag.RetrieveAll, "all", "a", false, fmt.Sprintf("Retrieve all %s using pagination", noun))
+	cmd.Flags().IntVar(&pagination_flag.SleepTime, "sleep", 0, "Seconds to wait between API requests when paginating")
+	cmd.Flags().BoolVar(&pagination_flag.Summary, "summary", false, "Write a run summary (pages fetched/failed, coverage) alongside the output")
+	cmd.Flags().BoolVar(&pagination_flag.KeepDuplicates, "keep-duplicates", false, fmt.Sprintf("Keep %s repeated across pages instead of de-duplicating them by pk (for debugging)", noun))
+	cmd.Flags().IntVar(&pagination_flag.MaxItems, "max-items", 0, fmt.Sprintf("Stop paginating once this many %s were collected (implies --all)", noun))
+	cmd.Flags().IntVar(&pagination_flag.MaxPages, "max-pages", 0, "Stop paginating once this many pages were fetched (implies --all)")
+}
+
+// writePaginated filters, renders and writes the records collected by a paginated command,
+// then reports the pagination summary like followers and following do.
+// table renders the filtered records when the output is a table; the summary is added below it.
+func writePaginated(
+	records []map[string]interface{},
+	table func(records []map[string]interface{}) (string, error),
+	summary pagination_service.Summary,
+) {
+	log_service.LogConditionally(pterm.DefaultLogger.Info, summary.String())
+	if summary.NextMaxID != "" {
+		// Printed on stderr so it does not mix with the results
+		pterm.DefaultLogger.WithWriter(os.Stderr).Info(
+			fmt.Sprintf("More results are available. Pass %s as the cursor argument to continue", summary.NextMaxID),
+		)
+	}
+
+	if output_service.Format() == output_flag.FormatSQLite {
+		pterm.DefaultLogger.Error("--format sqlite is only supported by the followers, following, likers and user commands")
+		os.Exit(1)
+	}
+
+	// Keep only the records matching --filter
+	records, err := filter_service.Apply(records)
+	if err != nil {
+		pterm.DefaultLogger.Error(err.Error())
+		os.Exit(1)
+	}
+
+	// Render as a table, JSON, NDJSON or CSV
+	result, err := output_service.Render(records, records, func() (string, error) {
+		rendered, err := table(records)
+		if err != nil {
+			return "", err
+		}
+		return rendered + "\n" + summary.String(), nil
+	})
+	if err != nil {
+		pterm.DefaultLogger.Error(fmt.Sprintf("Failed to render output: %s", err))
+		os.Exit(1)
+	}
+
+	// Print or save output
+	output_service.PrintConditionally(result)
+	if err := output_service.WriteConditionally(result); err != nil {
+		pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+		os.Exit(1)
+	}
+
+	writeSummary(summary)
+}
+
+// writeSummary writes the run summary alongside the output when --summary is set.
+func writeSummary(summary pagination_service.Summary) {
+	if !pagination_flag.Summary {
+		return
+	}
+	summaryJSON, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		pterm.DefaultLogger.Error(fmt.Sprintf("Failed to convert summary to JSON: %s", err))
+		os.Exit(1)
+	}
+	if err := output_service.WriteAlongside(".summary.json", string(summaryJSON)); err != nil {
+		pterm.DefaultLogger.Error(fmt.Sprintf("Error writing summary: %s", err))
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	query_flag "github.com/Rfluid/insta-tools/src/query/flag"
+	sqlite_service "github.com/Rfluid/insta-tools/src/sqlite/service"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// queryCmd represents the query command
+var queryCmd = &cobra.Command{
+	Use:   "query [SQL]",
+	Short: "Run a SQL query against a database written with --format sqlite",
+	Long: `This command runs a SQL query against a SQLite database created with --format sqlite.
+
+The database has three tables:
+1. users: one row per account (pk, username, full_name, is_private, is_verified, ...).
+2. relationships: one row per fetched relationship (source_pk, user_pk, direction, fetched_at),
+   where direction is "followers" (the user follows the source) or "following" (the source follows the user).
+3. likes: one row per fetched like (media_pk, user_pk, fetched_at), written by likers.
+
+The database is opened read-only.
+
+Example:
+  insta-tools query --db graph.db "SELECT u.username FROM relationships r JOIN users u ON u.pk = r.user_pk WHERE r.direction = 'followers'"`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		statement := args[0]
+
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Running query on %s: %s", query_flag.Database, statement),
+		)
+
+		columns, rows, err := sqlite_service.Query(query_flag.Database, statement)
+		if err != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error running query: %s", err))
+			os.Exit(1)
+		}
+
+		// Render the rows as a table or JSON
+		result, err := output_service.Render(rows, rows, func() (string, error) {
+			return output_service.RecordsTable(columns, rows)
+		})
+		if err != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Failed to render output: %s", err))
+			os.Exit(1)
+		}
+
+		output_service.PrintConditionally(result)
+		if err := output_service.WriteConditionally(result); err != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(queryCmd)
+
+	queryCmd.Flags().StringVar(&query_flag.Database, "db", "", "Path of the SQLite database written with --format sqlite")
+	queryCmd.MarkFlagRequired("db")
+}
@@ -0,0 +1,72 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	filter_service "github.com/Rfluid/insta-tools/src/filter/service"
+	output_flag "github.com/Rfluid/insta-tools/src/output/flag"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	user_service "github.com/Rfluid/insta-tools/src/user/service"
+	"github.com/pterm/pterm"
+)
+
+// writeRecords filters, renders and writes the records of a command that is not paginated.
+// columns are the record keys shown when rendering a table.
+func writeRecords(records []map[string]interface{}, columns []string) {
+	// Keep only the records matching --filter
+	records, err := filter_service.Apply(records)
+	if err != nil {
+		pterm.DefaultLogger.Error(err.Error())
+		os.Exit(1)
+	}
+
+	renderRecords(records, columns)
+}
+
+// renderRecords renders and writes records as they are, for commands that use --filter for
+// something else than their output.
+func renderRecords(records []map[string]interface{}, columns []string) {
+	if output_service.Format() == output_flag.FormatSQLite {
+		pterm.DefaultLogger.Error("--format sqlite is only supported by the followers, following, likers and user commands")
+		os.Exit(1)
+	}
+
+	// Render as a table, JSON, NDJSON or CSV
+	result, err := output_service.Render(records, records, func() (string, error) {
+		return output_service.RecordsTable(columns, records)
+	})
+	if err != nil {
+		pterm.DefaultLogger.Error(fmt.Sprintf("Failed to render output: %s", err))
+		os.Exit(1)
+	}
+
+	// Print or save output
+	output_service.PrintConditionally(result)
+	if err := output_service.WriteConditionally(result); err != nil {
+		pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+		os.Exit(1)
+	}
+}
+
+// writeLookupErrors reports the accounts that could not be looked up out of total and writes them
+// to an error report alongside the output (or on stderr without -o).
+func writeLookupErrors(failures []user_service.LookupError, total int) {
+	if len(failures) == 0 {
+		return
+	}
+	pterm.DefaultLogger.Error(fmt.Sprintf("%d of %d users could not be fetched", len(failures), total))
+
+	failuresJSON, err := json.MarshalIndent(failures, "", "  ")
+	if err != nil {
+		pterm.DefaultLogger.Error(fmt.Sprintf("Failed to convert error report to JSON: %s", err))
+		os.Exit(1)
+	}
+	if err := output_service.WriteAlongside(".errors.json", string(failuresJSON)); err != nil {
+		pterm.DefaultLogger.Error(fmt.Sprintf("Error writing error report: %s", err))
+	}
+}
@@ -4,11 +4,17 @@ Copyright © 2025 Rfluid
 package cmd
 
 import (
+	"errors"
 	"os"
 
 	cookie_flag "github.com/Rfluid/insta-tools/src/cookie/flag"
+	filter_flag "github.com/Rfluid/insta-tools/src/filter/flag"
+	filter_service "github.com/Rfluid/insta-tools/src/filter/service"
 	log_flag "github.com/Rfluid/insta-tools/src/log/flag"
 	output_flag "github.com/Rfluid/insta-tools/src/output/flag"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	progress_flag "github.com/Rfluid/insta-tools/src/progress/flag"
+	ratelimit_flag "github.com/Rfluid/insta-tools/src/ratelimit/flag"
 	thread_flag "github.com/Rfluid/insta-tools/src/thread/flag"
 	"github.com/spf13/cobra"
 )
@@ -22,6 +28,19 @@ allowing users to perform various actions such as fetching followers and getting
 	// Uncomment the following line if your bare application
 	// has an action associated with it:
 	// Run: func(cmd *cobra.Command, args []string) { },
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := output_service.ValidateFormat(); err != nil {
+			return err
+		}
+		// Fail before fetching anything rather than when rendering
+		if output_service.Format() == output_flag.FormatHTML && cmd != dmExportCmd {
+			return errors.New("--format html is only supported by dm export")
+		}
+		if err := output_service.LoadTemplate(); err != nil {
+			return err
+		}
+		return filter_service.Load()
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -43,7 +62,13 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&log_flag.Logs, "logs", false, "Enable logs for better user experience")
 	rootCmd.PersistentFlags().StringVar(&cookie_flag.Cookies, "cookies", "", "Set Instagram session cookies")
 	rootCmd.PersistentFlags().StringVarP(&output_flag.OutputPath, "output", "o", "", "Set the output file path where results will be written")
+	rootCmd.PersistentFlags().StringVar(&output_flag.Format, "format", "", "Output format: json, table, ndjson, csv, sqlite or html (default table on a terminal, json otherwise)")
+	rootCmd.PersistentFlags().StringVar(&output_flag.Template, "template", "", "Go template rendered once per record, e.g. '{{.Username}}\\t{{.FullName}}'")
+	rootCmd.PersistentFlags().StringVar(&output_flag.TemplateFile, "template-file", "", "File containing a Go template rendered once per record")
+	rootCmd.PersistentFlags().StringVar(&filter_flag.Expression, "filter", "", "jq expression evaluated per record; records for which it is false or null are dropped, e.g. '.is_verified'")
 	rootCmd.PersistentFlags().IntVar(&thread_flag.APIThreads, "threads", 4, "Number of threads to use in concurrent API calls")
+	rootCmd.PersistentFlags().IntVar(&ratelimit_flag.RequestsPerMinute, "rate-limit", 0, "Maximum number of API requests per minute across all threads, 0 for no limit (act commands default to 20)")
+	rootCmd.PersistentFlags().BoolVar(&progress_flag.Disabled, "no-progress", false, "Disable the progress bar shown on stderr while paginating")
 
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
@@ -0,0 +1,76 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	search_flag "github.com/Rfluid/insta-tools/src/search/flag"
+	search_service "github.com/Rfluid/insta-tools/src/search/service"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// searchCmd represents the search command
+var searchCmd = &cobra.Command{
+	Use:   "search [query...]",
+	Short: "Search Instagram for users, hashtags and places",
+	Long: `This command runs Instagram's top search and lists the matching users, hashtags and
+places in the order Instagram ranks them.
+
+Each result has its rank, type, id, name (username, hashtag or place title) and a detail
+(full name, post count or address). Use --type to keep a single type, e.g. to resolve a
+fuzzy name to an account before running user or followers.
+
+Example:
+  insta-tools search "mark zuckerberg" --type user --cookies "<your_cookies>"`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse arguments
+		query := strings.Join(args, " ")
+		switch search_flag.Type {
+		case "", search_service.TypeUser, search_service.TypeHashtag, search_service.TypePlace:
+		default:
+			pterm.DefaultLogger.Error(fmt.Sprintf("Invalid --type %q. Must be user, hashtag or place", search_flag.Type))
+			os.Exit(1)
+		}
+
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		log_service.LogConditionally(pterm.DefaultLogger.Info, fmt.Sprintf("Searching for %q", query))
+
+		data, err := search_service.Get(query, cookies)
+		if err != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error searching: %s", err))
+			os.Exit(1)
+		}
+
+		// Keep only the results of --type
+		var results []search_service.Result
+		for _, result := range search_service.FromResponse(data) {
+			if search_flag.Type == "" || result.Type == search_flag.Type {
+				results = append(results, result)
+			}
+		}
+
+		records, err := output_service.ToRecords(results)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		writeRecords(records, search_service.TableColumns)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(searchCmd)
+
+	searchCmd.Flags().StringVar(&search_flag.Type, "type", "", "Keep only results of this type: user, hashtag or place")
+}
@@ -0,0 +1,144 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	download_flag "github.com/Rfluid/insta-tools/src/download/flag"
+	download_service "github.com/Rfluid/insta-tools/src/download/service"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	parser_service "github.com/Rfluid/insta-tools/src/parser/service"
+	stories_flag "github.com/Rfluid/insta-tools/src/stories/flag"
+	stories_service "github.com/Rfluid/insta-tools/src/stories/service"
+	user_service "github.com/Rfluid/insta-tools/src/user/service"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// storiesCmd represents the stories command
+var storiesCmd = &cobra.Command{
+	Use:   "stories [user]",
+	Short: "Retrieve the current stories of an Instagram account",
+	Long: `This command lists the story items an account currently has up, with their type,
+timestamp and media URL. Stories expire after 24 hours, so archive them with --download:
+
+  <dir>/<user>/stories/<date>_<pk>.<ext>
+
+With --download, the output is the result of each download instead of the list of items.
+
+Example:
+  insta-tools stories zuck --download --dir ./archive --cookies "<your_cookies>"`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		userID, err := user_service.ResolveID(args[0], cookies)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+
+		stories, err := stories_service.Stories(userID, cookies)
+		if err != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching stories: %s", err))
+			os.Exit(1)
+		}
+
+		if stories_flag.Download {
+			files := download_service.StoryFiles(download_flag.Directory, stories)
+			if !writeDownloads(files) {
+				os.Exit(1)
+			}
+			return
+		}
+
+		records, err := output_service.ToRecords(stories)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		writeRecords(records, stories_service.StoryColumns)
+	},
+}
+
+// highlightsCmd represents the highlights command
+var highlightsCmd = &cobra.Command{
+	Use:   "highlights [user]",
+	Short: "Retrieve the highlight reels of an Instagram account",
+	Long: `This command lists the highlight reels of an account with their title, cover, item count
+and items (type, timestamp and media URL). Archive them with --download:
+
+  <dir>/<user>/highlights/<title>_<id>/<date>_<pk>.<ext>   (and the cover as cover.<ext>)
+
+With --download, the output is the result of each download instead of the list of reels.
+
+Example:
+  insta-tools highlights zuck --download --dir ./archive --cookies "<your_cookies>"`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		userID, err := user_service.ResolveID(args[0], cookies)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+
+		highlights, reqErr := stories_service.Highlights(userID, cookies, download_flag.SleepTime)
+		if reqErr != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching highlights: %s", reqErr))
+			if highlights == nil {
+				os.Exit(1)
+			}
+		}
+
+		if stories_flag.Download {
+			files := download_service.HighlightFiles(download_flag.Directory, highlightsOwner(args[0], highlights), highlights)
+			if !writeDownloads(files) || reqErr != nil {
+				os.Exit(1)
+			}
+			return
+		}
+
+		records, err := output_service.ToRecords(highlights)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		writeRecords(records, stories_service.HighlightColumns)
+		if reqErr != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+// highlightsOwner returns the username the highlights are archived under: the owner of their items,
+// or the user given on the command line.
+func highlightsOwner(user string, highlights []stories_service.Highlight) string {
+	for _, highlight := range highlights {
+		for _, item := range highlight.Items {
+			if item.Owner != "" {
+				return item.Owner
+			}
+		}
+	}
+	username, _ := parser_service.Username(user)
+	return username
+}
+
+func init() {
+	rootCmd.AddCommand(storiesCmd)
+	rootCmd.AddCommand(highlightsCmd)
+
+	for _, cmd := range []*cobra.Command{storiesCmd, highlightsCmd} {
+		cmd.Flags().BoolVar(&stories_flag.Download, "download", false, "Download the items into --dir instead of listing them")
+		cmd.Flags().StringVar(&download_flag.Directory, "dir", ".", "Directory where files are saved, with one sub-directory per account")
+		cmd.Flags().IntVar(&download_flag.SleepTime, "sleep", 0, "Seconds to wait between API requests and between downloads of each thread")
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright © 2025 Rfluid
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	media_service "github.com/Rfluid/insta-tools/src/media/service"
+	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	user_service "github.com/Rfluid/insta-tools/src/user/service"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// taggedCmd represents the tagged command
+var taggedCmd = &cobra.Command{
+	Use:   "tagged [user] [count] [maxID]",
+	Short: "Retrieve the posts an Instagram account is tagged in",
+	Long: `This command fetches the posts in which a given Instagram account is tagged.
+
+Arguments:
+1. A username or userID.
+2. An optional batch count (number of posts per request, default 12).
+3. An optional maxID to paginate requests.
+
+Posts have the same fields as in the media command; owner is the account that posted them.
+
+Example:
+  insta-tools tagged ourbrand --all --format csv -o tagged.csv --cookies "<your_cookies>"`,
+	Args: cobra.RangeArgs(1, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Parse arguments
+		count := 12
+		if len(args) >= 2 {
+			var err error
+			count, err = strconv.Atoi(args[1])
+			if err != nil {
+				pterm.DefaultLogger.Error("Invalid count argument. Must be an integer.")
+				os.Exit(1)
+			}
+		}
+		maxID := ""
+		if len(args) == 3 {
+			maxID = args[2]
+		}
+
+		// Parse cookies
+		cookies := cookie_service.ParseCookies()
+
+		userID, err := user_service.ResolveID(args[0], cookies)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching tagged media for userID: %s with count: %d and initial maxID: %s", userID, count, maxID),
+		)
+
+		// Fetch tagged media using pagination. Profiles do not expose how many posts tag them,
+		// so there is no total for a progress bar or coverage
+		media, summary, reqErr := media_service.GetAllTagged(userID, cookies, count, pagination_service.FlagOptions(maxID))
+		if reqErr != nil {
+			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching tagged media: %s. Only partial results available", reqErr))
+		}
+
+		records, err := output_service.ToRecords(media)
+		if err != nil {
+			pterm.DefaultLogger.Error(err.Error())
+			os.Exit(1)
+		}
+		writePaginated(records, columnsTable(media_service.TaggedColumns), summary)
+		if reqErr != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(taggedCmd)
+
+	addPaginationFlags(taggedCmd, "tagged media")
+}
@@ -4,13 +4,19 @@ Copyright © 2025 Rfluid
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 
 	cookie_service "github.com/Rfluid/insta-tools/src/cookie/service"
+	filter_service "github.com/Rfluid/insta-tools/src/filter/service"
+	input_service "github.com/Rfluid/insta-tools/src/input/service"
 	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	output_flag "github.com/Rfluid/insta-tools/src/output/flag"
 	output_service "github.com/Rfluid/insta-tools/src/output/service"
+	parser_service "github.com/Rfluid/insta-tools/src/parser/service"
+	sqlite_service "github.com/Rfluid/insta-tools/src/sqlite/service"
+	thread_flag "github.com/Rfluid/insta-tools/src/thread/flag"
+	user_flag "github.com/Rfluid/insta-tools/src/user/flag"
 	user_service "github.com/Rfluid/insta-tools/src/user/service"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
@@ -18,39 +24,159 @@ import (
 
 // userCmd represents the user command
 var userCmd = &cobra.Command{
-	Use:   "user [username]",
+	Use:   "user [username|URL]",
 	Short: "Retrieve the user of an Instagram account",
-	Long: `This command fetches the user of a given Instagram username.
+	Long: `This command fetches the user of a given Instagram username or profile URL.
+
+With --id, the profile is looked up by numeric user ID (pk) instead, which keeps working
+after the account changes its username.
+
+With --from-file, it fetches many profiles concurrently instead, reading one username (or profile URL) per line
+from a file (or stdin with "-"), and outputs a summary of each profile. Names that could not be
+fetched are reported separately (alongside -o, or on stderr). Private accounts are not
+failures: their summary is output like any other, with is_private set to true.
 
 Example:
-  insta-tools user zuck --cookies "<your_cookies>"`,
-	Args: cobra.ExactArgs(1),
+  insta-tools user zuck --cookies "<your_cookies>"
+  insta-tools user --id 314216 --cookies "<your_cookies>"
+  insta-tools user --from-file names.txt --format csv -o profiles.csv --cookies "<your_cookies>"`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if user_flag.FromFile != "" || user_flag.ID != "" {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	},
 	Run: func(cmd *cobra.Command, args []string) {
-		// Get the username from the command arguments
-		username := args[0]
-
 		// Parse cookies
 		cookies := cookie_service.ParseCookies()
 
-		// Fetch user profile info
-		log_service.LogConditionally(pterm.DefaultLogger.Info, fmt.Sprintf("Fetching user for %s", username))
+		// Batch lookup from a file or stdin
+		if user_flag.FromFile != "" {
+			lines, err := input_service.ReadLines(user_flag.FromFile)
+			if err != nil {
+				pterm.DefaultLogger.Error(err.Error())
+				os.Exit(1)
+			}
+
+			// Lines may hold profile URLs; the ones that are not are reported with the failed names
+			var (
+				usernames []string
+				invalid   []user_service.LookupError
+			)
+			for _, line := range lines {
+				username, err := parser_service.Username(line)
+				if err != nil {
+					invalid = append(invalid, user_service.LookupError{Username: line, Reason: user_service.ReasonInvalid, Error: err.Error()})
+					continue
+				}
+				usernames = append(usernames, username)
+			}
+
+			log_service.LogConditionally(
+				pterm.DefaultLogger.Info,
+				fmt.Sprintf("Fetching %d users with %d threads", len(usernames), thread_flag.APIThreads),
+			)
+
+			profiles, failures := user_service.GetMany(usernames, cookies, thread_flag.APIThreads, user_flag.SleepTime)
+			failures = append(invalid, failures...)
+
+			// Summarize the profiles and keep only the ones matching --filter
+			var (
+				kept      []map[string]interface{}
+				summaries []map[string]interface{}
+			)
+			for _, profile := range profiles {
+				summary := user_service.Summarize(profile)
+				match, err := filter_service.Match(summary)
+				if err != nil {
+					pterm.DefaultLogger.Error(err.Error())
+					os.Exit(1)
+				}
+				if match {
+					kept = append(kept, profile)
+					summaries = append(summaries, summary)
+				}
+			}
+
+			if output_service.Format() == output_flag.FormatSQLite {
+				// Store into the SQLite database given by -o
+				if err := sqlite_service.SaveProfiles(output_flag.OutputPath, kept); err != nil {
+					pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+					os.Exit(1)
+				}
+			} else {
+				// Render the summaries as a table, JSON, NDJSON or CSV
+				result, err := output_service.Render(summaries, summaries, func() (string, error) {
+					return output_service.RecordsTable(user_service.SummaryColumns, summaries)
+				})
+				if err != nil {
+					pterm.DefaultLogger.Error(fmt.Sprintf("Failed to render output: %s", err))
+					os.Exit(1)
+				}
+
+				output_service.PrintConditionally(result)
+				if err := output_service.WriteConditionally(result); err != nil {
+					pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+					os.Exit(1)
+				}
+			}
 
-		data, reqErr := user_service.Get(username, cookies)
+			// Report the names that failed separately
+			if len(failures) > 0 {
+				writeLookupErrors(failures, len(lines))
+				os.Exit(1)
+			}
+
+			return
+		}
+
+		var (
+			data   map[string]interface{}
+			reqErr error
+		)
+		if user_flag.ID != "" {
+			// Fetch user profile info by ID
+			log_service.LogConditionally(pterm.DefaultLogger.Info, fmt.Sprintf("Fetching user for ID %s", user_flag.ID))
+
+			data, reqErr = user_service.GetByID(user_flag.ID, cookies)
+		} else {
+			// Get the username from the command arguments, which may be a profile URL
+			username, err := parser_service.Username(args[0])
+			if err != nil {
+				pterm.DefaultLogger.Error(err.Error())
+				os.Exit(1)
+			}
+
+			// Fetch user profile info
+			log_service.LogConditionally(pterm.DefaultLogger.Info, fmt.Sprintf("Fetching user for %s", username))
+
+			data, reqErr = user_service.Get(username, cookies)
+		}
 		if reqErr != nil {
 			pterm.DefaultLogger.Error(fmt.Sprintf("Error fetching user: %s", reqErr))
 		}
 
-		// Convert map[string]interface{} to JSON
-		resultJSON, err := json.MarshalIndent(data, "", "  ")
-		if err != nil {
-			pterm.DefaultLogger.Error(fmt.Sprintf("Failed to convert data to JSON: %s", err))
-			os.Exit(1)
-		}
+		if output_service.Format() == output_flag.FormatSQLite {
+			// Store into the SQLite database given by -o
+			if err := sqlite_service.SaveProfiles(output_flag.OutputPath, output_service.ProfileRecords(data)); err != nil {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+				os.Exit(1)
+			}
+		} else {
+			// Render the profile as a table or JSON
+			result, err := output_service.Render(data, output_service.ProfileRecords(data), func() (string, error) {
+				return output_service.ProfileTable(data)
+			})
+			if err != nil {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Failed to render output: %s", err))
+				os.Exit(1)
+			}
 
-		output_service.PrintConditionally(string(resultJSON))
-		if err := output_service.WriteConditionally(string(resultJSON)); err != nil {
-			pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
-			os.Exit(1)
+			output_service.PrintConditionally(result)
+			if err := output_service.WriteConditionally(result); err != nil {
+				pterm.DefaultLogger.Error(fmt.Sprintf("Error writing output: %s", err))
+				os.Exit(1)
+			}
 		}
 		if reqErr != nil {
 			os.Exit(1)
@@ -70,4 +196,8 @@ func init() {
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
 	// userCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	userCmd.Flags().StringVar(&user_flag.FromFile, "from-file", "", "Fetch the profiles of the usernames listed in this file, one per line (\"-\" for stdin)")
+	userCmd.Flags().StringVar(&user_flag.ID, "id", "", "Look up the profile by numeric user ID (pk) instead of username")
+	userCmd.Flags().IntVar(&user_flag.SleepTime, "sleep", 0, "Seconds to wait between API requests when using --from-file")
+	userCmd.MarkFlagsMutuallyExclusive("id", "from-file")
 }
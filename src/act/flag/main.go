@@ -0,0 +1,12 @@
+package act_flag
+
+var (
+	FromFile  string // File with one account per line or user records, "-" for stdin
+	DryRun    bool   // Only preview the actions
+	Yes       bool   // Skip the interactive confirmation
+	ActionLog string // NDJSON file every performed action is appended to
+
+	// Selection of pending follow requests
+	Verified      bool // Only requests from verified accounts
+	HasProfilePic bool // Only requests from accounts with a profile picture
+)
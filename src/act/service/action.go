@@ -0,0 +1,15 @@
+package act_service
+
+// Action is a change made by the logged-in account to its relationship with another account.
+type Action struct {
+	Name string // Used in logs and the action log
+	URL  string // Endpoint, with %s replaced by the user ID
+}
+
+// Actions on other accounts
+var (
+	ActionUnfollow       = Action{Name: "unfollow", URL: "https://www.instagram.com/api/v1/friendships/destroy/%s/"}
+	ActionRemoveFollower = Action{Name: "remove_follower", URL: "https://www.instagram.com/api/v1/friendships/remove_follower/%s/"}
+	ActionAccept         = Action{Name: "accept_request", URL: "https://www.instagram.com/api/v1/friendships/approve/%s/"}
+	ActionReject         = Action{Name: "reject_request", URL: "https://www.instagram.com/api/v1/friendships/ignore/%s/"}
+)
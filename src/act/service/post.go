@@ -0,0 +1,73 @@
+package act_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	ratelimit_service "github.com/Rfluid/insta-tools/src/ratelimit/service"
+	"github.com/pterm/pterm"
+)
+
+// Post performs action on userID and returns the API response as a map[string]interface{}.
+// Instagram only accepts these requests with the CSRF token of the session.
+func Post(action Action, userID string, cookies map[string]string) (map[string]interface{}, error) {
+	form := url.Values{}
+	form.Set("user_id", userID)
+
+	req, err := http.NewRequest("POST", fmt.Sprintf(action.URL, userID), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Add headers
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("content-type", "application/x-www-form-urlencoded")
+	req.Header.Set("x-csrftoken", cookies["csrftoken"])
+
+	// Add cookies to the request
+	for key, value := range cookies {
+		req.AddCookie(&http.Cookie{Name: key, Value: value})
+	}
+
+	// Execute the request
+	resp, err := ratelimit_service.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check if response is successful
+	if resp.StatusCode != http.StatusOK {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Error,
+			fmt.Sprintf("Error performing %s. API status code is %v", action.Name, resp.StatusCode),
+		)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return nil, err
+		}
+
+		return result, fmt.Errorf("bad status code (%v) in API response", resp.StatusCode)
+	}
+
+	// Parse the JSON response
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// Some refusals come with a 200 status
+	if status, _ := result["status"].(string); status != "ok" {
+		message, _ := result["message"].(string)
+		return result, fmt.Errorf("action refused: %s", message)
+	}
+
+	return result, nil
+}
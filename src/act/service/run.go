@@ -0,0 +1,93 @@
+package act_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	user_service "github.com/Rfluid/insta-tools/src/user/service"
+	"github.com/pterm/pterm"
+)
+
+// Statuses of an action
+const (
+	StatusDryRun = "dry_run" // Previewed only
+	StatusDone   = "done"
+	StatusFailed = "failed"
+)
+
+// Result is the outcome of an action on an account.
+type Result struct {
+	Action   string    `json:"action"`
+	UserID   string    `json:"user_id"`
+	Username string    `json:"username,omitempty"`
+	Status   string    `json:"status"`
+	Error    string    `json:"error,omitempty"`
+	At       time.Time `json:"at"`
+}
+
+// ResultColumns are the fields shown when results are rendered as a table.
+var ResultColumns = []string{"action", "username", "user_id", "status", "error"}
+
+// Preview returns the results of a dry run of action on accounts, without making any request.
+func Preview(action Action, accounts []user_service.Account) []Result {
+	results := make([]Result, len(accounts))
+	for i, account := range accounts {
+		results[i] = Result{
+			Action:   action.Name,
+			UserID:   account.ID,
+			Username: account.Username,
+			Status:   StatusDryRun,
+			At:       time.Now().UTC(),
+		}
+	}
+	return results
+}
+
+// Run performs action on accounts one at a time, as fast as --rate-limit allows.
+// Each result is appended to the action log at logPath as soon as it is known, so the log is
+// complete even if the run is interrupted. onResult, if set, is called after each account.
+func Run(
+	action Action,
+	accounts []user_service.Account,
+	cookies map[string]string,
+	logPath string,
+	onResult func(result Result),
+) ([]Result, error) {
+	logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open action log: %w", err)
+	}
+	defer logFile.Close()
+	encoder := json.NewEncoder(logFile)
+
+	results := make([]Result, 0, len(accounts))
+	for _, account := range accounts {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Performing %s on %s", action.Name, account.ID),
+		)
+		result := Result{
+			Action:   action.Name,
+			UserID:   account.ID,
+			Username: account.Username,
+			Status:   StatusDone,
+		}
+		if _, err := Post(action, account.ID, cookies); err != nil {
+			result.Status = StatusFailed
+			result.Error = err.Error()
+		}
+		result.At = time.Now().UTC()
+
+		if err := encoder.Encode(result); err != nil {
+			return results, fmt.Errorf("failed to write action log: %w", err)
+		}
+		results = append(results, result)
+		if onResult != nil {
+			onResult(result)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,59 @@
+package act_service
+
+import (
+	"strings"
+
+	parser_service "github.com/Rfluid/insta-tools/src/parser/service"
+	user_service "github.com/Rfluid/insta-tools/src/user/service"
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+)
+
+// Selection narrows down a list of users, e.g. pending follow requests, before acting on them.
+type Selection struct {
+	Verified      bool     // Only verified accounts
+	HasProfilePic bool     // Only accounts with a profile picture
+	Listed        []string // Only these accounts (usernames, IDs or profile URLs), when not empty
+}
+
+// Select returns the accounts of the users matching the selection, in the same order.
+func (s Selection) Select(users []map[string]interface{}) []user_service.Account {
+	listed := make(map[string]bool, len(s.Listed))
+	for _, user := range s.Listed {
+		if username, err := parser_service.Username(user); err == nil {
+			listed[strings.ToLower(username)] = true
+		}
+	}
+
+	var accounts []user_service.Account
+	for _, user := range users {
+		account := user_service.Account{ID: value_service.String(user["pk"]), Username: value_service.String(user["username"])}
+		if account.ID == "" {
+			continue
+		}
+		if s.Verified && user["is_verified"] != true {
+			continue
+		}
+		if s.HasProfilePic && user["has_anonymous_profile_picture"] == true {
+			continue
+		}
+		if len(listed) > 0 && !listed[account.ID] && !listed[strings.ToLower(account.Username)] {
+			continue
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
+// Unique drops the accounts listed more than once, keeping the first occurrence of each ID.
+func Unique(accounts []user_service.Account) []user_service.Account {
+	seen := make(map[string]bool, len(accounts))
+	unique := make([]user_service.Account, 0, len(accounts))
+	for _, account := range accounts {
+		if seen[account.ID] {
+			continue
+		}
+		seen[account.ID] = true
+		unique = append(unique, account)
+	}
+	return unique
+}
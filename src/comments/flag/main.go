@@ -0,0 +1,3 @@
+package comments_flag
+
+var Replies bool // Fetch every reply of each comment
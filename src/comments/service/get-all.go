@@ -0,0 +1,132 @@
+package comments_service
+
+import (
+	"errors"
+	"fmt"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+	"github.com/pterm/pterm"
+)
+
+// GetAll retrieves the comments of a post page by page. With replies, every reply of each comment
+// is fetched as well and listed right after its parent; their pages are counted in the summary.
+func GetAll(
+	mediaID string,
+	cookies map[string]string,
+	opts pagination_service.Options,
+	replies bool,
+) ([]Comment, pagination_service.Summary, error) {
+	fetch := func(cursor string) (pagination_service.Page, error) {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching comments of %s for cursor: %s", mediaID, cursor),
+		)
+		result, err := Get(mediaID, cookies, cursor)
+		if err != nil {
+			return pagination_service.Page{}, err
+		}
+
+		items, err := itemsOf(result, "comments")
+		if err != nil {
+			return pagination_service.Page{}, err
+		}
+
+		// Newer API versions page with next_min_id, older ones with next_max_id
+		next := value_service.String(result["next_min_id"])
+		if maxID := value_service.String(result["next_max_id"]); next == "" && maxID != "" {
+			next = MaxIDPrefix + maxID
+		}
+		return pagination_service.Page{Items: items, NextMaxID: next}, nil
+	}
+
+	items, summary, err := pagination_service.GetAll(fetch, opts)
+
+	var (
+		comments []Comment
+		errs     = []error{err}
+	)
+	for _, item := range items {
+		comment := FromItem(mediaID, item)
+		comments = append(comments, comment)
+
+		if !replies || comment.ReplyCount == 0 {
+			continue
+		}
+		children, childSummary, childErr := getReplies(mediaID, comment.ID, cookies, pagination_service.Options{
+			Threads:        opts.Threads,
+			SleepTime:      opts.SleepTime,
+			KeepDuplicates: opts.KeepDuplicates,
+		})
+		comments = append(comments, children...)
+
+		summary.PagesFetched += childSummary.PagesFetched
+		summary.PagesFailed = append(summary.PagesFailed, childSummary.PagesFailed...)
+		summary.ItemsCollected += childSummary.ItemsCollected
+		summary.DuplicatesRemoved += childSummary.DuplicatesRemoved
+		errs = append(errs, childErr)
+	}
+
+	return comments, summary, errors.Join(errs...)
+}
+
+// getReplies retrieves every reply to a comment.
+func getReplies(
+	mediaID string,
+	commentID string,
+	cookies map[string]string,
+	opts pagination_service.Options,
+) ([]Comment, pagination_service.Summary, error) {
+	fetch := func(maxID string) (pagination_service.Page, error) {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching replies to comment %s for maxID: %s", commentID, maxID),
+		)
+		result, err := GetReplies(mediaID, commentID, cookies, maxID)
+		if err != nil {
+			return pagination_service.Page{}, err
+		}
+
+		items, err := itemsOf(result, "child_comments")
+		if err != nil {
+			return pagination_service.Page{}, err
+		}
+
+		next := value_service.String(result["next_max_child_cursor"])
+		if more, ok := result["has_more_tail_child_comments"].(bool); ok && !more {
+			next = ""
+		}
+		return pagination_service.Page{Items: items, NextMaxID: next}, nil
+	}
+
+	items, summary, err := pagination_service.GetAll(fetch, opts)
+
+	replies := make([]Comment, 0, len(items))
+	for _, item := range items {
+		reply := FromItem(mediaID, item)
+		if reply.ParentID == "" {
+			reply.ParentID = commentID
+		}
+		replies = append(replies, reply)
+	}
+	return replies, summary, err
+}
+
+// itemsOf extracts the array of comments stored under key.
+func itemsOf(result map[string]interface{}, key string) ([]map[string]interface{}, error) {
+	batch, ok := result[key].([]interface{})
+	if !ok {
+		// Invalid response format
+		return nil, fmt.Errorf("invalid response format; missing '%s' array", key)
+	}
+
+	// Convert []interface{} → []map[string]interface{}
+	var items []map[string]interface{}
+	for _, item := range batch {
+		if m, ok := item.(map[string]interface{}); ok {
+			items = append(items, m)
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,110 @@
+package comments_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	ratelimit_service "github.com/Rfluid/insta-tools/src/ratelimit/service"
+	"github.com/pterm/pterm"
+)
+
+// MaxIDPrefix marks the cursors taken from next_max_id, which must be sent back as max_id
+// rather than min_id
+const MaxIDPrefix = "max_id:"
+
+// Get makes a request to Instagram's API and returns a page of the comments of a post as a map[string]interface{}.
+// cursor is a next_min_id, or a next_max_id marked with MaxIDPrefix.
+func Get(
+	mediaID string,
+	cookies map[string]string,
+	cursor string,
+) (map[string]interface{}, error) {
+	// Construct the request URL with media ID
+	url := fmt.Sprintf("https://www.instagram.com/api/v1/media/%s/comments/", mediaID)
+
+	query := map[string]string{
+		"can_support_threading": "true",
+		"permalink_enabled":     "false",
+	}
+	if maxID, ok := strings.CutPrefix(cursor, MaxIDPrefix); ok {
+		query["max_id"] = maxID
+	} else if cursor != "" {
+		query["min_id"] = cursor
+	}
+	return get(url, cookies, query)
+}
+
+// GetReplies makes a request to Instagram's API and returns a page of the replies to a comment as a map[string]interface{}
+func GetReplies(
+	mediaID string,
+	commentID string,
+	cookies map[string]string,
+	maxID string,
+) (map[string]interface{}, error) {
+	// Construct the request URL with media and comment IDs
+	url := fmt.Sprintf("https://www.instagram.com/api/v1/media/%s/comments/%s/child_comments/", mediaID, commentID)
+
+	query := map[string]string{}
+	if maxID != "" {
+		query["max_id"] = maxID
+	}
+	return get(url, cookies, query)
+}
+
+func get(url string, cookies map[string]string, params map[string]string) (map[string]interface{}, error) {
+	// Build query parameters
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add headers
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	// Add cookies to the request
+	for key, value := range cookies {
+		req.AddCookie(&http.Cookie{Name: key, Value: value})
+	}
+
+	// Add query parameters
+	query := req.URL.Query()
+	for key, value := range params {
+		query.Add(key, value)
+	}
+	req.URL.RawQuery = query.Encode()
+
+	// Execute the request
+	resp, err := ratelimit_service.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check if response is successful
+	if resp.StatusCode != http.StatusOK {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Error,
+			fmt.Sprintf("Error fetching comments. API status code is %v", resp.StatusCode),
+		)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return nil, err
+		}
+
+		return result, fmt.Errorf("bad status code (%v) in API response", resp.StatusCode)
+	}
+
+	// Parse the JSON response
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
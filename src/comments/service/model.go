@@ -0,0 +1,43 @@
+package comments_service
+
+import (
+	"time"
+
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+)
+
+// Comment is a comment or a reply to a comment on a post.
+type Comment struct {
+	ID         string    `json:"id"`
+	MediaID    string    `json:"media_id"`
+	ParentID   string    `json:"parent_id,omitempty"` // Set on replies
+	Author     string    `json:"author"`
+	AuthorID   string    `json:"author_id"`
+	Text       string    `json:"text"`
+	CreatedAt  time.Time `json:"created_at"`
+	LikeCount  int       `json:"like_count"`
+	ReplyCount int       `json:"reply_count"`
+}
+
+// TableColumns are the fields shown when comments are rendered as a table.
+var TableColumns = []string{"author", "created_at", "like_count", "reply_count", "parent_id", "text"}
+
+// FromItem converts a raw comment into a Comment.
+func FromItem(mediaID string, item map[string]interface{}) Comment {
+	comment := Comment{
+		ID:         value_service.String(item["pk"]),
+		MediaID:    mediaID,
+		ParentID:   value_service.String(item["parent_comment_id"]),
+		Text:       value_service.String(item["text"]),
+		LikeCount:  value_service.Int(item["comment_like_count"]),
+		ReplyCount: value_service.Int(item["child_comment_count"]),
+	}
+	if user, ok := item["user"].(map[string]interface{}); ok {
+		comment.Author = value_service.String(user["username"])
+		comment.AuthorID = value_service.String(user["pk"])
+	}
+	if createdAt, ok := item["created_at"].(float64); ok {
+		comment.CreatedAt = time.Unix(int64(createdAt), 0).UTC()
+	}
+	return comment
+}
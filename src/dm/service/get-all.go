@@ -0,0 +1,132 @@
+package dm_service
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+	"github.com/pterm/pterm"
+)
+
+// GetAllThreads retrieves the direct message threads of the logged-in account page by page,
+// following the inbox cursor from the most recently active thread.
+func GetAllThreads(
+	cookies map[string]string,
+	opts pagination_service.Options,
+) ([]Thread, pagination_service.Summary, error) {
+	fetch := func(cursor string) (pagination_service.Page, error) {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching direct message threads for cursor: %s", cursor),
+		)
+		result, err := GetInbox(cookies, cursor)
+		if err != nil {
+			return pagination_service.Page{}, err
+		}
+
+		inbox, ok := result["inbox"].(map[string]interface{})
+		if !ok {
+			// Invalid response format
+			return pagination_service.Page{}, fmt.Errorf("invalid response format; missing 'inbox' object")
+		}
+		return cursorPage(inbox, "threads")
+	}
+
+	items, summary, err := pagination_service.GetAll(fetch, opts)
+
+	threads := make([]Thread, 0, len(items))
+	for _, item := range items {
+		threads = append(threads, FromThread(item))
+	}
+	return threads, summary, err
+}
+
+// GetAllMessages retrieves the messages of a thread page by page, from the newest to the oldest,
+// and returns them in chronological order along with the thread they belong to.
+func GetAllMessages(
+	threadID string,
+	cookies map[string]string,
+	opts pagination_service.Options,
+) (Thread, []Message, pagination_service.Summary, error) {
+	var (
+		mu        sync.Mutex
+		rawThread map[string]interface{} // First thread response, holding its members
+	)
+	fetch := func(cursor string) (pagination_service.Page, error) {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching messages of thread %s for cursor: %s", threadID, cursor),
+		)
+		result, err := GetThread(threadID, cookies, cursor)
+		if err != nil {
+			return pagination_service.Page{}, err
+		}
+
+		thread, ok := result["thread"].(map[string]interface{})
+		if !ok {
+			// Invalid response format
+			return pagination_service.Page{}, fmt.Errorf("invalid response format; missing 'thread' object")
+		}
+
+		mu.Lock()
+		if rawThread == nil {
+			rawThread = thread
+		}
+		mu.Unlock()
+
+		return cursorPage(thread, "items")
+	}
+
+	items, summary, err := pagination_service.GetAll(fetch, opts)
+
+	var thread Thread
+	usernames := make(map[string]string)
+	viewerID := ""
+	if rawThread != nil {
+		thread = FromThread(rawThread)
+		for i, id := range thread.ParticipantIDs {
+			usernames[id] = thread.Participants[i]
+		}
+		viewerID = value_service.String(rawThread["viewer_id"])
+	}
+
+	messages := make([]Message, 0, len(items))
+	for _, item := range items {
+		messages = append(messages, FromItem(threadID, item, usernames, viewerID))
+	}
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].SentAt.Before(messages[j].SentAt)
+	})
+	return thread, messages, summary, err
+}
+
+// cursorPage converts an inbox or thread object into a page of the entries stored under key.
+// Older entries are reached with oldest_cursor while has_older is true.
+func cursorPage(container map[string]interface{}, key string) (pagination_service.Page, error) {
+	batch, ok := container[key].([]interface{})
+	if !ok {
+		// Invalid response format
+		return pagination_service.Page{}, fmt.Errorf("invalid response format; missing '%s' array", key)
+	}
+
+	// Convert []interface{} → []map[string]interface{}
+	var items []map[string]interface{}
+	for _, item := range batch {
+		if m, ok := item.(map[string]interface{}); ok {
+			items = append(items, m)
+		}
+	}
+
+	cursor := value_service.String(container["oldest_cursor"])
+	if older, _ := container["has_older"].(bool); !older {
+		cursor = ""
+	}
+
+	return pagination_service.Page{
+		Items:     items,
+		NextMaxID: cursor,
+	}, nil
+}
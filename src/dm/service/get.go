@@ -0,0 +1,98 @@
+package dm_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	ratelimit_service "github.com/Rfluid/insta-tools/src/ratelimit/service"
+	"github.com/pterm/pterm"
+)
+
+// Number of threads or messages requested per page
+const pageLimit = 20
+
+// GetInbox makes a request to Instagram's API and returns a page of the direct message threads of
+// the logged-in account as a map[string]interface{}
+func GetInbox(cookies map[string]string, cursor string) (map[string]interface{}, error) {
+	params := map[string]string{
+		"persistentBadging":    "true",
+		"limit":                fmt.Sprintf("%d", pageLimit),
+		"thread_message_limit": "1",
+	}
+	if cursor != "" {
+		params["cursor"] = cursor
+	}
+	return get("https://www.instagram.com/api/v1/direct_v2/inbox/", cookies, params)
+}
+
+// GetThread makes a request to Instagram's API and returns a direct message thread with a page of
+// its messages as a map[string]interface{}
+func GetThread(threadID string, cookies map[string]string, cursor string) (map[string]interface{}, error) {
+	// Construct the request URL with thread ID
+	url := fmt.Sprintf("https://www.instagram.com/api/v1/direct_v2/threads/%s/", threadID)
+
+	params := map[string]string{
+		"limit": fmt.Sprintf("%d", pageLimit),
+	}
+	if cursor != "" {
+		params["cursor"] = cursor
+	}
+	return get(url, cookies, params)
+}
+
+func get(url string, cookies map[string]string, params map[string]string) (map[string]interface{}, error) {
+	// Build query parameters
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add headers
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	// Add cookies to the request
+	for key, value := range cookies {
+		req.AddCookie(&http.Cookie{Name: key, Value: value})
+	}
+
+	// Add query parameters
+	query := req.URL.Query()
+	for key, value := range params {
+		query.Add(key, value)
+	}
+	req.URL.RawQuery = query.Encode()
+
+	// Execute the request
+	resp, err := ratelimit_service.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check if response is successful
+	if resp.StatusCode != http.StatusOK {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Error,
+			fmt.Sprintf("Error fetching direct messages. API status code is %v", resp.StatusCode),
+		)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return nil, err
+		}
+
+		return result, fmt.Errorf("bad status code (%v) in API response", resp.StatusCode)
+	}
+
+	// Parse the JSON response
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,75 @@
+package dm_service
+
+import (
+	"bytes"
+	"html/template"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// page is a self-contained HTML page showing a thread as a conversation
+var page = template.Must(template.New("thread").Funcs(template.FuncMap{
+	"isImage": isImage,
+	"time": func(t time.Time) string {
+		return t.Format("2006-01-02 15:04:05 MST")
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{if .Thread.Title}}{{.Thread.Title}}{{else}}Thread {{.Thread.ID}}{{end}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Roboto, sans-serif; max-width: 48rem; margin: 2rem auto; padding: 0 1rem; color: #222; }
+header { border-bottom: 1px solid #ddd; margin-bottom: 1rem; }
+.message { margin: .5rem 0; padding: .5rem .75rem; border-radius: .75rem; background: #efefef; max-width: 75%; }
+.message.me { margin-left: auto; background: #dbeafe; }
+.meta { font-size: .75rem; color: #666; }
+.text { white-space: pre-wrap; overflow-wrap: anywhere; }
+img { max-width: 100%; max-height: 20rem; display: block; margin-top: .25rem; }
+</style>
+</head>
+<body>
+<header>
+<h1>{{if .Thread.Title}}{{.Thread.Title}}{{else}}Thread {{.Thread.ID}}{{end}}</h1>
+<p>Participants: {{range $i, $p := .Thread.Participants}}{{if $i}}, {{end}}{{$p}}{{end}}</p>
+<p class="meta">Thread {{.Thread.ID}}, {{len .Messages}} messages, exported {{time .ExportedAt}}</p>
+</header>
+{{range .Messages}}<div class="message{{if .FromMe}} me{{end}}">
+<div class="meta">{{if .FromMe}}You{{else if .Sender}}{{.Sender}}{{else}}{{.SenderID}}{{end}} · {{time .SentAt}}{{if ne .Type "text"}} · {{.Type}}{{end}}</div>
+{{if .Text}}<div class="text">{{.Text}}</div>{{end}}
+{{range .Links}}<div><a href="{{.}}">{{.}}</a></div>{{end}}
+{{range .Media}}<div>{{if isImage .}}<a href="{{.}}"><img src="{{.}}" alt="attachment" loading="lazy"></a>{{else}}<a href="{{.}}">attachment</a>{{end}}</div>{{end}}
+</div>
+{{end}}</body>
+</html>
+`))
+
+// RenderHTML renders a thread and its messages as a self-contained HTML page.
+// Media are linked rather than embedded, and their URLs expire after a while.
+func RenderHTML(thread Thread, messages []Message) (string, error) {
+	var buffer bytes.Buffer
+	err := page.Execute(&buffer, map[string]interface{}{
+		"Thread":     thread,
+		"Messages":   messages,
+		"ExportedAt": time.Now().UTC(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// isImage reports whether a media URL points to a photo.
+func isImage(rawURL string) bool {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(path.Ext(parsed.Path)) {
+	case ".jpg", ".jpeg", ".png", ".webp", ".heic", ".gif":
+		return true
+	}
+	return false
+}
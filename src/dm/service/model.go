@@ -0,0 +1,190 @@
+package dm_service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	media_service "github.com/Rfluid/insta-tools/src/media/service"
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+)
+
+// Thread is a direct message conversation of the logged-in account.
+type Thread struct {
+	ID             string    `json:"id"`
+	Title          string    `json:"title"`
+	Participants   []string  `json:"participants"` // Usernames of the other members
+	ParticipantIDs []string  `json:"participant_ids"`
+	IsGroup        bool      `json:"is_group"`
+	LastActivityAt time.Time `json:"last_activity_at"`
+	LastMessage    string    `json:"last_message"` // Text of the latest message, when it has one
+}
+
+// Message is a message of a direct message thread.
+type Message struct {
+	ID       string    `json:"id"`
+	ThreadID string    `json:"thread_id"`
+	SenderID string    `json:"sender_id"`
+	Sender   string    `json:"sender"`  // Username of the sender, empty for your own messages
+	FromMe   bool      `json:"from_me"` // Sent by the logged-in account
+	Type     string    `json:"type"`    // text, link, media, media_share, clip, voice_media...
+	Text     string    `json:"text"`
+	SentAt   time.Time `json:"sent_at"`
+	Media    []string  `json:"media,omitempty"` // URLs of attached photos, videos and audio
+	Links    []string  `json:"links,omitempty"` // Shared links and posts
+}
+
+// ThreadColumns are the fields shown when threads are rendered as a table.
+var ThreadColumns = []string{"id", "title", "participants", "last_activity_at", "last_message"}
+
+// MessageColumns are the fields shown when messages are rendered as a table.
+var MessageColumns = []string{"sent_at", "sender", "type", "text"}
+
+// FromThread converts a raw thread into a Thread.
+func FromThread(thread map[string]interface{}) Thread {
+	isGroup, _ := thread["is_group"].(bool)
+	result := Thread{
+		ID:             value_service.String(thread["thread_id"]),
+		Title:          value_service.String(thread["thread_title"]),
+		IsGroup:        isGroup,
+		LastActivityAt: micros(thread["last_activity_at"]),
+		Participants:   []string{},
+		ParticipantIDs: []string{},
+	}
+
+	users, _ := thread["users"].([]interface{})
+	for _, user := range users {
+		if userMap, ok := user.(map[string]interface{}); ok {
+			result.Participants = append(result.Participants, value_service.String(userMap["username"]))
+			result.ParticipantIDs = append(result.ParticipantIDs, value_service.String(userMap["pk"]))
+		}
+	}
+
+	// Inbox threads come with their latest message
+	items, _ := thread["items"].([]interface{})
+	if len(items) > 0 {
+		if item, ok := items[0].(map[string]interface{}); ok {
+			result.LastMessage = FromItem(result.ID, item, nil, "").Text
+		}
+	}
+	return result
+}
+
+// FromItem converts a raw thread item into a Message. usernames maps the IDs of the members of
+// the thread to their usernames; viewerID is the ID of the logged-in account.
+func FromItem(threadID string, item map[string]interface{}, usernames map[string]string, viewerID string) Message {
+	message := Message{
+		ID:       value_service.String(item["item_id"]),
+		ThreadID: threadID,
+		SenderID: value_service.String(item["user_id"]),
+		Type:     value_service.String(item["item_type"]),
+		Text:     value_service.String(item["text"]),
+		SentAt:   micros(item["timestamp"]),
+	}
+	message.FromMe = viewerID != "" && message.SenderID == viewerID
+	if !message.FromMe {
+		message.Sender = usernames[message.SenderID]
+	}
+
+	switch message.Type {
+	case "link":
+		link, _ := item["link"].(map[string]interface{})
+		message.Text = value_service.String(link["text"])
+		if context, ok := link["link_context"].(map[string]interface{}); ok {
+			message.Links = appendNonEmpty(message.Links, value_service.String(context["link_url"]))
+		}
+	case "media_share", "clip", "story_share", "felix_share":
+		// Shared posts link to the original post, and also carry its media
+		shared := sharedMedia(item, message.Type)
+		if shared != nil {
+			post := media_service.FromItem(shared)
+			if post.Shortcode != "" {
+				message.Links = append(message.Links, fmt.Sprintf("https://www.instagram.com/p/%s/", post.Shortcode))
+			}
+			message.Media = appendMedia(message.Media, post)
+		}
+	case "reel_share":
+		reelShare, _ := item["reel_share"].(map[string]interface{})
+		message.Text = value_service.String(reelShare["text"])
+		if media, ok := reelShare["media"].(map[string]interface{}); ok {
+			message.Media = appendMedia(message.Media, media_service.FromItem(media))
+		}
+	case "media":
+		if media, ok := item["media"].(map[string]interface{}); ok {
+			message.Media = appendMedia(message.Media, media_service.FromItem(media))
+		}
+	case "visual_media", "raven_media":
+		// Disappearing photos and videos, available until they are seen
+		visual, _ := item[message.Type].(map[string]interface{})
+		if media, ok := visual["media"].(map[string]interface{}); ok {
+			message.Media = appendMedia(message.Media, media_service.FromItem(media))
+		}
+	case "voice_media":
+		voice, _ := item["voice_media"].(map[string]interface{})
+		media, _ := voice["media"].(map[string]interface{})
+		audio, _ := media["audio"].(map[string]interface{})
+		message.Media = appendNonEmpty(message.Media, value_service.String(audio["audio_src"]))
+	case "animated_media":
+		animated, _ := item["animated_media"].(map[string]interface{})
+		images, _ := animated["images"].(map[string]interface{})
+		fixed, _ := images["fixed_height"].(map[string]interface{})
+		message.Media = appendNonEmpty(message.Media, value_service.String(fixed["url"]))
+	case "like":
+		message.Text = value_service.String(item["like"])
+	}
+
+	return message
+}
+
+// sharedMedia returns the post shared by a *_share or clip item.
+func sharedMedia(item map[string]interface{}, itemType string) map[string]interface{} {
+	switch itemType {
+	case "clip":
+		clip, _ := item["clip"].(map[string]interface{})
+		media, _ := clip["clip"].(map[string]interface{})
+		return media
+	case "story_share":
+		share, _ := item["story_share"].(map[string]interface{})
+		media, _ := share["media"].(map[string]interface{})
+		return media
+	case "felix_share":
+		share, _ := item["felix_share"].(map[string]interface{})
+		media, _ := share["video"].(map[string]interface{})
+		return media
+	default:
+		media, _ := item["media_share"].(map[string]interface{})
+		return media
+	}
+}
+
+// appendMedia appends the URLs of a photo, video or carousel.
+func appendMedia(urls []string, media media_service.Media) []string {
+	urls = appendNonEmpty(urls, media.URL)
+	for _, child := range media.Children {
+		urls = appendNonEmpty(urls, child.URL)
+	}
+	return urls
+}
+
+func appendNonEmpty(values []string, value string) []string {
+	if strings.TrimSpace(value) == "" {
+		return values
+	}
+	return append(values, value)
+}
+
+// micros converts a timestamp in microseconds, given as a number or a string, into a time.
+func micros(value interface{}) time.Time {
+	var us int64
+	switch v := value.(type) {
+	case float64:
+		us = int64(v)
+	case string:
+		us, _ = strconv.ParseInt(v, 10, 64)
+	}
+	if us == 0 {
+		return time.Time{}
+	}
+	return time.UnixMicro(us).UTC()
+}
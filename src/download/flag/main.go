@@ -0,0 +1,8 @@
+package download_flag
+
+var (
+	Directory  string // Root directory where files are saved, one sub-directory per account
+	FromExport string // Media export written by the media command (JSON or NDJSON)
+	Refresh    bool   // Fetch fresh media URLs for the posts of FromExport
+	SleepTime  int
+)
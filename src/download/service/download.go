@@ -0,0 +1,157 @@
+package download_service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	"github.com/pterm/pterm"
+)
+
+// DownloadAll downloads files concurrently with a pool of threads workers, each waiting sleepTime
+// seconds between downloads. Files that already exist are skipped but still hashed, so the
+// results can be used to build a complete checksum manifest. Results keep the order of files.
+func DownloadAll(files []File, threads int, sleepTime int) []Result {
+	results := make([]Result, len(files))
+
+	// Channel of tasks, where each task is the index of the file to download
+	taskChan := make(chan int)
+
+	var wg sync.WaitGroup // WaitGroup for workers
+
+	worker := func() {
+		defer wg.Done()
+
+		for i := range taskChan {
+			results[i] = download(files[i])
+			if results[i].Status == StatusDownloaded {
+				// Optional rate limiting
+				time.Sleep(time.Duration(sleepTime) * time.Second)
+			}
+		}
+	}
+
+	// Spin up N workers
+	for i := 0; i < max(threads, 1); i++ {
+		wg.Add(1)
+		go worker()
+	}
+
+	for i := range files {
+		taskChan <- i
+	}
+	close(taskChan)
+	wg.Wait()
+
+	return results
+}
+
+// download saves a single file, skipping it if it already exists.
+func download(file File) Result {
+	result := Result{Path: file.Path, URL: file.URL}
+
+	if _, err := os.Stat(file.Path); err == nil {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Skipping %s: already exists", file.Path),
+		)
+		hash, size, err := hashFile(file.Path)
+		if err != nil {
+			result.Status = StatusFailed
+			result.Error = err.Error()
+			return result
+		}
+		result.Status = StatusSkipped
+		result.SHA256 = hash
+		result.Size = size
+		return result
+	}
+
+	log_service.LogConditionally(
+		pterm.DefaultLogger.Info,
+		fmt.Sprintf("Downloading %s", file.Path),
+	)
+	hash, size, err := fetch(file)
+	if err != nil {
+		result.Status = StatusFailed
+		result.Error = err.Error()
+		return result
+	}
+	result.Status = StatusDownloaded
+	result.SHA256 = hash
+	result.Size = size
+	return result
+}
+
+// fetch downloads file.URL into file.Path through a temporary file, so interrupted downloads
+// are never mistaken for complete files by the skip-if-exists check.
+func fetch(file File) (string, int64, error) {
+	if file.URL == "" {
+		return "", 0, errors.New("missing URL")
+	}
+
+	req, err := http.NewRequest("GET", file.URL, nil)
+	if err != nil {
+		return "", 0, err
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("bad status code (%v) in download response", resp.StatusCode)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file.Path), 0o755); err != nil {
+		return "", 0, err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(file.Path), ".download-*")
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.Remove(tmp.Name()) // No-op once renamed
+
+	hasher := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hasher), resp.Body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", 0, err
+	}
+
+	if err := os.Rename(tmp.Name(), file.Path); err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), size, nil
+}
+
+func hashFile(path string) (string, int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	size, err := io.Copy(hasher, file)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), size, nil
+}
@@ -0,0 +1,34 @@
+package download_service
+
+// Headers sent when downloading files from Instagram's CDN
+var headers = map[string]string{
+	"accept":          "*/*",
+	"accept-language": "pt-BR,pt;q=0.9,en-US;q=0.8,en;q=0.7",
+	"user-agent":      "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/133.0.0.0 Safari/537.36",
+}
+
+// File is a file to download.
+type File struct {
+	URL  string
+	Path string
+}
+
+// Statuses of a download
+const (
+	StatusDownloaded = "downloaded"
+	StatusSkipped    = "skipped" // The file already existed
+	StatusFailed     = "failed"
+)
+
+// Result is the outcome of downloading a File.
+type Result struct {
+	Path   string `json:"path"`
+	URL    string `json:"url"`
+	Status string `json:"status"`
+	SHA256 string `json:"sha256,omitempty"`
+	Size   int64  `json:"size,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ResultColumns are the fields shown when results are rendered as a table.
+var ResultColumns = []string{"path", "status", "size", "sha256", "error"}
@@ -0,0 +1,84 @@
+package download_service
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ManifestName is the checksum manifest kept in every account directory, in sha256sum format
+// so it can be verified with `sha256sum -c SHA256SUMS`.
+const ManifestName = "SHA256SUMS"
+
+// WriteManifests merges the checksums of successful results into the manifest of the directory
+// holding each file.
+func WriteManifests(results []Result) error {
+	byDir := make(map[string][]Result)
+	for _, result := range results {
+		if result.SHA256 == "" {
+			continue
+		}
+		dir := filepath.Dir(result.Path)
+		byDir[dir] = append(byDir[dir], result)
+	}
+
+	for dir, dirResults := range byDir {
+		path := filepath.Join(dir, ManifestName)
+		sums, err := readManifest(path)
+		if err != nil {
+			return err
+		}
+		for _, result := range dirResults {
+			sums[filepath.Base(result.Path)] = result.SHA256
+		}
+		if err := writeManifest(path, sums); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readManifest reads "<hash>  <name>" lines into a name → hash map.
+func readManifest(path string) (map[string]string, error) {
+	sums := make(map[string]string)
+
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return sums, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		hash, name, ok := strings.Cut(scanner.Text(), "  ")
+		if ok {
+			sums[name] = hash
+		}
+	}
+	return sums, scanner.Err()
+}
+
+func writeManifest(path string, sums map[string]string) error {
+	names := make([]string, 0, len(sums))
+	for name := range sums {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s  %s\n", sums[name], name)
+	}
+
+	if err := os.WriteFile(path, []byte(b.String()), 0o644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package download_service
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	media_service "github.com/Rfluid/insta-tools/src/media/service"
+	stories_service "github.com/Rfluid/insta-tools/src/stories/service"
+)
+
+// MediaFiles plans the files of posts as <dir>/<owner>/<date>_<shortcode>_<n>.<ext>,
+// where n numbers the items of a carousel starting at 1.
+func MediaFiles(dir string, media []media_service.Media) []File {
+	var files []File
+	for _, post := range media {
+		owner := ownerDir(post.Owner)
+		date := post.TakenAt.Format(time.DateOnly)
+
+		items := post.Children
+		if len(items) == 0 {
+			items = []media_service.Media{post}
+		}
+		for i, item := range items {
+			if item.URL == "" {
+				continue
+			}
+			name := fmt.Sprintf("%s_%s_%d%s", date, safeName(post.Shortcode, "unknown"), i+1, extension(item.URL, item.Type))
+			files = append(files, File{
+				URL:  item.URL,
+				Path: filepath.Join(dir, owner, name),
+			})
+		}
+	}
+	return files
+}
+
+// StoryFiles plans story items as <dir>/<owner>/stories/<date>_<pk>.<ext>.
+func StoryFiles(dir string, stories []media_service.Media) []File {
+	var files []File
+	for _, story := range stories {
+		if story.URL == "" {
+			continue
+		}
+		name := fmt.Sprintf("%s_%s%s", story.TakenAt.Format(time.DateOnly), safeName(story.PK, "unknown"), extension(story.URL, story.Type))
+		files = append(files, File{
+			URL:  story.URL,
+			Path: filepath.Join(dir, ownerDir(story.Owner), "stories", name),
+		})
+	}
+	return files
+}
+
+// HighlightFiles plans the items of highlight reels of owner as <dir>/<owner>/highlights/<title>_<id>/<date>_<pk>.<ext>,
+// next to the cover of the reel saved as cover.<ext>.
+func HighlightFiles(dir string, owner string, highlights []stories_service.Highlight) []File {
+	var files []File
+	for _, highlight := range highlights {
+		id := strings.TrimPrefix(highlight.ID, "highlight:")
+		reelDir := filepath.Join(dir, ownerDir(owner), "highlights", safeName(highlight.Title, "untitled")+"_"+safeName(id, "unknown"))
+
+		if highlight.Cover != "" {
+			files = append(files, File{
+				URL:  highlight.Cover,
+				Path: filepath.Join(reelDir, "cover"+extension(highlight.Cover, media_service.TypeImage)),
+			})
+		}
+		for _, item := range highlight.Items {
+			if item.URL == "" {
+				continue
+			}
+			name := fmt.Sprintf("%s_%s%s", item.TakenAt.Format(time.DateOnly), safeName(item.PK, "unknown"), extension(item.URL, item.Type))
+			files = append(files, File{
+				URL:  item.URL,
+				Path: filepath.Join(reelDir, name),
+			})
+		}
+	}
+	return files
+}
+
+// ProfilePictureFile plans the HD profile picture of username as <dir>/<username>/<date>_profile.<ext>,
+// keeping one picture per day so changes over time are archived.
+func ProfilePictureFile(dir string, username string, pictureURL string) File {
+	name := fmt.Sprintf("%s_profile%s", time.Now().Format(time.DateOnly), extension(pictureURL, media_service.TypeImage))
+	return File{
+		URL:  pictureURL,
+		Path: filepath.Join(dir, ownerDir(username), name),
+	}
+}
+
+// extension takes the extension from the URL path, falling back on the media type.
+func extension(rawURL string, mediaType string) string {
+	if parsed, err := url.Parse(rawURL); err == nil {
+		if ext := path.Ext(parsed.Path); ext != "" && ext == safeName(ext, "") {
+			return ext
+		}
+	}
+	if mediaType == media_service.TypeVideo {
+		return ".mp4"
+	}
+	return ".jpg"
+}
+
+// ownerDir is the directory name of an account, "unknown" when the owner is missing or unsafe.
+func ownerDir(owner string) string {
+	return safeName(owner, "unknown")
+}
+
+// safeName replaces the characters of a title that are not safe in file names, so names read from
+// exports or API responses cannot point outside of their directory. It returns fallback for names
+// that are empty or only made of dots, like "..".
+func safeName(title string, fallback string) string {
+	name := strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|':
+			return '_'
+		}
+		if r < ' ' {
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(title))
+	if strings.Trim(name, ".") == "" {
+		return fallback
+	}
+	return name
+}
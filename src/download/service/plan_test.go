@@ -0,0 +1,64 @@
+package download_service
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	media_service "github.com/Rfluid/insta-tools/src/media/service"
+	stories_service "github.com/Rfluid/insta-tools/src/stories/service"
+)
+
+// TestPlannedPathsStayInDir checks that names read from exports cannot point outside of the directory.
+func TestPlannedPathsStayInDir(t *testing.T) {
+	dir := filepath.Join("archive", "dir")
+	hostile := []string{"", ".", "..", "../..", "../../x", "/etc", `..\..\x`, "a/../../b"}
+
+	var files []File
+	for _, name := range hostile {
+		post := media_service.Media{PK: name, Shortcode: name, Owner: name, URL: "https://cdn.example/a/b.jpg"}
+		files = append(files, MediaFiles(dir, []media_service.Media{post})...)
+		files = append(files, StoryFiles(dir, []media_service.Media{post})...)
+		files = append(files, HighlightFiles(dir, name, []stories_service.Highlight{{
+			ID:    "highlight:" + name,
+			Title: name,
+			Cover: "https://cdn.example/cover",
+			Items: []media_service.Media{post},
+		}})...)
+		files = append(files, ProfilePictureFile(dir, name, "https://cdn.example/p/../x.jpg"))
+	}
+
+	for _, file := range files {
+		rel, err := filepath.Rel(dir, file.Path)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			t.Errorf("planned path %q is outside of %q", file.Path, dir)
+		}
+		for _, part := range strings.Split(rel, string(filepath.Separator)) {
+			if strings.Trim(part, ".") == "" {
+				t.Errorf("planned path %q has the unsafe part %q", file.Path, part)
+			}
+		}
+	}
+}
+
+func TestSafeName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Summer 2024", "Summer 2024"},
+		{"a/b", "a_b"},
+		{`a\b:c`, "a_b_c"},
+		{"", "fallback"},
+		{"  ", "fallback"},
+		{".", "fallback"},
+		{"..", "fallback"},
+		{"../..", ".._.."},
+		{".hidden", ".hidden"},
+	}
+	for _, test := range tests {
+		if got := safeName(test.name, "fallback"); got != test.want {
+			t.Errorf("safeName(%q) = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+package filter_flag
+
+var Expression string // jq expression evaluated per record; records for which it is false or null are dropped
@@ -0,0 +1,102 @@
+package filter_service
+
+import (
+	"fmt"
+
+	filter_flag "github.com/Rfluid/insta-tools/src/filter/flag"
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	"github.com/itchyny/gojq"
+	"github.com/pterm/pterm"
+)
+
+// code is the compiled --filter expression, set by Load.
+var code *gojq.Code
+
+// Load compiles the --filter expression, if any.
+func Load() error {
+	if filter_flag.Expression == "" {
+		return nil
+	}
+
+	query, err := gojq.Parse(filter_flag.Expression)
+	if err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+	compiled, err := gojq.Compile(query)
+	if err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+	code = compiled
+	return nil
+}
+
+// Match reports whether the record satisfies the filter. Like jq's select, the first value
+// produced by the expression decides: false and null drop the record, anything else keeps it.
+// Every record matches when no filter is set.
+func Match(record map[string]interface{}) (bool, error) {
+	if code == nil {
+		return true, nil
+	}
+
+	iter := code.Run(record)
+	value, ok := iter.Next()
+	if !ok {
+		return false, nil
+	}
+	if err, isErr := value.(error); isErr {
+		return false, fmt.Errorf("failed to evaluate filter: %w", err)
+	}
+	return value != nil && value != false, nil
+}
+
+// Apply keeps the records that satisfy the filter.
+func Apply(records []map[string]interface{}) ([]map[string]interface{}, error) {
+	if code == nil {
+		return records, nil
+	}
+
+	kept := make([]map[string]interface{}, 0, len(records))
+	for _, record := range records {
+		match, err := Match(record)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			kept = append(kept, record)
+		}
+	}
+
+	log_service.LogConditionally(
+		pterm.DefaultLogger.Info,
+		fmt.Sprintf("Filter kept %d of %d records", len(kept), len(records)),
+	)
+
+	return kept, nil
+}
+
+// ApplyPage keeps the records of the array stored under key in a raw API page (e.g. "users"),
+// leaving the rest of the page untouched.
+func ApplyPage(page map[string]interface{}, key string) error {
+	items, ok := page[key].([]interface{})
+	if !ok || code == nil {
+		return nil
+	}
+
+	kept := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		record, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		match, err := Match(record)
+		if err != nil {
+			return err
+		}
+		if match {
+			kept = append(kept, record)
+		}
+	}
+	page[key] = kept
+
+	return nil
+}
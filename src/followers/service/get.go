@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	ratelimit_service "github.com/Rfluid/insta-tools/src/ratelimit/service"
 	"github.com/pterm/pterm"
 )
 
@@ -45,8 +46,7 @@ func Get(
 	req.URL.RawQuery = query.Encode()
 
 	// Execute the request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := ratelimit_service.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,11 @@
 package following_flag
 
 var (
-	SleepTime   int
-	RetrieveAll bool
+	SleepTime      int
+	RetrieveAll    bool
+	Username       string // Username of the target, used to compare collected users against the profile's count
+	Summary        bool   // Write the run summary alongside the output
+	KeepDuplicates bool   // Keep users repeated across pages instead of dropping them by pk
+	MaxItems       int    // Stop paginating once this many users were collected
+	MaxPages       int    // Stop paginating once this many pages were fetched
 )
@@ -0,0 +1,7 @@
+package friendship_flag
+
+var (
+	FromFile  string // File with one account per line, "-" for stdin
+	Detailed  bool   // Fetch the full status of each account, one request per account
+	SleepTime int
+)
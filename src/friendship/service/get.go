@@ -0,0 +1,83 @@
+package friendship_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	ratelimit_service "github.com/Rfluid/insta-tools/src/ratelimit/service"
+	"github.com/pterm/pterm"
+)
+
+// GetMany makes a request to Instagram's bulk friendship endpoint and returns the status of the
+// session with each of userIDs as a map[string]interface{}
+func GetMany(userIDs []string, cookies map[string]string) (map[string]interface{}, error) {
+	form := url.Values{}
+	form.Set("user_ids", strings.Join(userIDs, ","))
+
+	req, err := http.NewRequest("POST", "https://www.instagram.com/api/v1/friendships/show_many/", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("content-type", "application/x-www-form-urlencoded")
+	req.Header.Set("x-csrftoken", cookies["csrftoken"])
+
+	return do(req, cookies)
+}
+
+// Get makes a request to Instagram's API and returns the full status of the session with userID as a map[string]interface{}
+func Get(userID string, cookies map[string]string) (map[string]interface{}, error) {
+	// Construct the request URL with user ID
+	url := fmt.Sprintf("https://www.instagram.com/api/v1/friendships/show/%s/", userID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return do(req, cookies)
+}
+
+func do(req *http.Request, cookies map[string]string) (map[string]interface{}, error) {
+	// Add headers
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	// Add cookies to the request
+	for key, value := range cookies {
+		req.AddCookie(&http.Cookie{Name: key, Value: value})
+	}
+
+	// Execute the request
+	resp, err := ratelimit_service.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check if response is successful
+	if resp.StatusCode != http.StatusOK {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Error,
+			fmt.Sprintf("Error fetching friendship status. API status code is %v", resp.StatusCode),
+		)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return nil, err
+		}
+
+		return result, fmt.Errorf("bad status code (%v) in API response", resp.StatusCode)
+	}
+
+	// Parse the JSON response
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,75 @@
+package friendship_service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	"github.com/pterm/pterm"
+)
+
+// Accounts are sent to the bulk endpoint in batches of this size
+const batchSize = 50
+
+// Lookup returns the status of the session with each of userIDs, in the same order.
+// The bulk endpoint is used in batches; with detailed, each account is then looked up on its
+// own for the fields the bulk endpoint leaves out. Statuses that could not be fetched are
+// left out and the errors are joined.
+func Lookup(userIDs []string, cookies map[string]string, detailed bool, sleepTime int) ([]Status, error) {
+	var (
+		statuses []Status
+		errs     []error
+	)
+	for start := 0; start < len(userIDs); start += batchSize {
+		if start > 0 {
+			// Optional rate limiting
+			time.Sleep(time.Duration(sleepTime) * time.Second)
+		}
+
+		batch := userIDs[start:min(start+batchSize, len(userIDs))]
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching friendship status of %d accounts", len(batch)),
+		)
+		result, err := GetMany(batch, cookies)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		byID, ok := result["friendship_statuses"].(map[string]interface{})
+		if !ok {
+			// Invalid response format
+			errs = append(errs, fmt.Errorf("invalid response format; missing 'friendship_statuses' object"))
+			continue
+		}
+
+		for _, userID := range batch {
+			status, ok := byID[userID].(map[string]interface{})
+			if !ok {
+				errs = append(errs, fmt.Errorf("no friendship status returned for %s", userID))
+				continue
+			}
+			statuses = append(statuses, fromStatus(userID, status))
+		}
+	}
+
+	if detailed {
+		for i := range statuses {
+			time.Sleep(time.Duration(sleepTime) * time.Second)
+
+			log_service.LogConditionally(
+				pterm.DefaultLogger.Info,
+				fmt.Sprintf("Fetching detailed friendship status of %s", statuses[i].UserID),
+			)
+			result, err := Get(statuses[i].UserID, cookies)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to fetch the detailed status of %s: %w", statuses[i].UserID, err))
+				continue
+			}
+			statuses[i] = fromStatus(statuses[i].UserID, result)
+		}
+	}
+
+	return statuses, errors.Join(errs...)
+}
@@ -0,0 +1,12 @@
+package friendship_service
+
+// Headers required for the request
+var headers = map[string]string{
+	"accept":           "*/*",
+	"accept-language":  "pt-BR,pt;q=0.9,en-US;q=0.8,en;q=0.7",
+	"priority":         "u=1, i",
+	"user-agent":       "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/133.0.0.0 Safari/537.36",
+	"x-asbd-id":        "359341",
+	"x-ig-app-id":      "936619743392459",
+	"x-requested-with": "XMLHttpRequest",
+}
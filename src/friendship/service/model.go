@@ -0,0 +1,44 @@
+package friendship_service
+
+// Status is the relationship between the logged-in account and another account.
+// Fields the endpoint did not report are null: the bulk endpoint leaves out followed_by,
+// blocking and muting, which only the detailed lookup returns.
+type Status struct {
+	UserID          string `json:"user_id"`
+	Username        string `json:"username,omitempty"`
+	Following       *bool  `json:"following"`        // You follow them
+	FollowedBy      *bool  `json:"followed_by"`      // They follow you
+	OutgoingRequest *bool  `json:"outgoing_request"` // You requested to follow them
+	IncomingRequest *bool  `json:"incoming_request"` // They requested to follow you
+	Blocking        *bool  `json:"blocking"`
+	Muting          *bool  `json:"muting"`
+	IsRestricted    *bool  `json:"is_restricted"`
+	IsPrivate       *bool  `json:"is_private"`
+}
+
+// TableColumns are the fields shown when statuses are rendered as a table.
+var TableColumns = []string{"username", "user_id", "following", "followed_by", "outgoing_request", "incoming_request", "blocking", "muting", "is_restricted"}
+
+// fromStatus converts a raw friendship status of userID into a Status.
+func fromStatus(userID string, status map[string]interface{}) Status {
+	return Status{
+		UserID:          userID,
+		Following:       boolean(status["following"]),
+		FollowedBy:      boolean(status["followed_by"]),
+		OutgoingRequest: boolean(status["outgoing_request"]),
+		IncomingRequest: boolean(status["incoming_request"]),
+		Blocking:        boolean(status["blocking"]),
+		Muting:          boolean(status["muting"]),
+		IsRestricted:    boolean(status["is_restricted"]),
+		IsPrivate:       boolean(status["is_private"]),
+	}
+}
+
+// boolean returns a JSON boolean, or nil when the value is missing.
+func boolean(value interface{}) *bool {
+	b, ok := value.(bool)
+	if !ok {
+		return nil
+	}
+	return &b
+}
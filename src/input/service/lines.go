@@ -0,0 +1,46 @@
+package input_service
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	"github.com/pterm/pterm"
+)
+
+// ReadLines reads one entry per line from path, or from stdin when path is "-".
+// Blank lines and lines starting with # are skipped.
+func ReadLines(path string) ([]string, error) {
+	var reader io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open input file: %w", err)
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read input: %w", err)
+	}
+
+	log_service.LogConditionally(
+		pterm.DefaultLogger.Info,
+		fmt.Sprintf("Read %d entries from %s", len(lines), path),
+	)
+
+	return lines, nil
+}
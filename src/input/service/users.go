@@ -0,0 +1,138 @@
+package input_service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	"github.com/pterm/pterm"
+)
+
+// ReadUsers reads the accounts listed in path, or in stdin when path is "-". The input is either
+// one account per line like ReadLines, or user records exported as a JSON array or NDJSON, e.g.
+// by followers or query, in which case the pk (or user_id, id) of each record is preferred over
+// its username.
+func ReadUsers(path string) ([]string, error) {
+	records, users, err := ReadUserRecords(path)
+	if err != nil || records == nil {
+		return users, err
+	}
+	return Users(records)
+}
+
+// ReadUserRecords reads path like ReadUsers, but returns the user records themselves so they
+// can be filtered. records is nil when the input has one account per line, which are returned
+// as users instead.
+func ReadUserRecords(path string) (records []map[string]interface{}, users []string, err error) {
+	var reader io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open input file: %w", err)
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read input: %w", err)
+	}
+	content = bytes.TrimSpace(content)
+
+	switch {
+	case bytes.HasPrefix(content, []byte("[")):
+		if err := decode(content, &records); err != nil {
+			return nil, nil, fmt.Errorf("invalid user export: %w", err)
+		}
+	case bytes.HasPrefix(content, []byte("{")):
+		scanner := bufio.NewScanner(bytes.NewReader(content))
+		scanner.Buffer(nil, 16*1024*1024)
+		for scanner.Scan() {
+			line := bytes.TrimSpace(scanner.Bytes())
+			if len(line) == 0 {
+				continue
+			}
+			var record map[string]interface{}
+			if err := decode(line, &record); err != nil {
+				return nil, nil, fmt.Errorf("invalid user export: %w", err)
+			}
+			records = append(records, record)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, nil, fmt.Errorf("failed to read input: %w", err)
+		}
+	default:
+		for _, line := range strings.Split(string(content), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			users = append(users, line)
+		}
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Read %d entries from %s", len(users), path),
+		)
+		return nil, users, nil
+	}
+
+	if records == nil {
+		// An empty export is still an export
+		records = []map[string]interface{}{}
+	}
+	log_service.LogConditionally(
+		pterm.DefaultLogger.Info,
+		fmt.Sprintf("Read %d user records from %s", len(records), path),
+	)
+	return records, nil, nil
+}
+
+// Users returns the account of each user record: its pk (or user_id, id), or else its username.
+func Users(records []map[string]interface{}) ([]string, error) {
+	users := make([]string, 0, len(records))
+	for i, record := range records {
+		user := ""
+		for _, key := range []string{"pk", "user_id", "id", "username"} {
+			if user = recordString(record[key]); user != "" {
+				break
+			}
+		}
+		if user == "" {
+			return nil, fmt.Errorf("invalid user export: record %d has no pk or username", i+1)
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// decode unmarshals JSON keeping numbers as written, so large IDs are not rounded.
+func decode(data []byte, value interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(value)
+}
+
+// recordString converts an ID read from a record to a string. Numbers are json.Number as decoded,
+// or int and *big.Int once --filter has evaluated the record.
+func recordString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case int:
+		return strconv.Itoa(v)
+	case *big.Int:
+		return v.String()
+	default:
+		return ""
+	}
+}
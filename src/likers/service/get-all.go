@@ -0,0 +1,52 @@
+package likers_service
+
+import (
+	"fmt"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	"github.com/pterm/pterm"
+)
+
+// GetAll retrieves the accounts that liked a post with the same machinery as the follow lists.
+// Users have the same shape as in followers and following, so the exports can be cross-referenced.
+func GetAll(
+	mediaID string,
+	cookies map[string]string,
+	opts pagination_service.Options,
+) ([]map[string]interface{}, pagination_service.Summary, error) {
+	fetch := func(maxID string) (pagination_service.Page, error) {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching likers of %s for maxID: %s", mediaID, maxID),
+		)
+		result, err := Get(mediaID, cookies, maxID)
+		if err != nil {
+			return pagination_service.Page{}, err
+		}
+
+		batch, ok := result["users"].([]interface{})
+		if !ok {
+			// Invalid response format
+			return pagination_service.Page{}, fmt.Errorf("invalid response format; missing 'users' array for maxID=%s", maxID)
+		}
+
+		// Convert []interface{} → []map[string]interface{}
+		var users []map[string]interface{}
+		for _, item := range batch {
+			if user, ok := item.(map[string]interface{}); ok {
+				users = append(users, user)
+			}
+		}
+
+		// Most posts return every liker at once; larger ones may hand out a cursor
+		nextMaxID, _ := result["next_max_id"].(string)
+
+		return pagination_service.Page{
+			Items:     users,
+			NextMaxID: nextMaxID,
+		}, nil
+	}
+
+	return pagination_service.GetAll(fetch, opts)
+}
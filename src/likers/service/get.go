@@ -0,0 +1,74 @@
+package likers_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	ratelimit_service "github.com/Rfluid/insta-tools/src/ratelimit/service"
+	"github.com/pterm/pterm"
+)
+
+// Get makes a request to Instagram's API and returns a page of the accounts that liked a post as a map[string]interface{}
+func Get(
+	mediaID string,
+	cookies map[string]string,
+	maxID string,
+) (map[string]interface{}, error) {
+	// Construct the request URL with media ID
+	url := fmt.Sprintf("https://www.instagram.com/api/v1/media/%s/likers/", mediaID)
+
+	// Build query parameters
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add headers
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	// Add cookies to the request
+	for key, value := range cookies {
+		req.AddCookie(&http.Cookie{Name: key, Value: value})
+	}
+
+	// Add query parameters
+	query := req.URL.Query()
+	if maxID != "" {
+		query.Add("max_id", maxID)
+	}
+	req.URL.RawQuery = query.Encode()
+
+	// Execute the request
+	resp, err := ratelimit_service.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check if response is successful
+	if resp.StatusCode != http.StatusOK {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Error,
+			fmt.Sprintf("Error fetching likers. API status code is %v", resp.StatusCode),
+		)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return nil, err
+		}
+
+		return result, fmt.Errorf("bad status code (%v) in API response", resp.StatusCode)
+	}
+
+	// Parse the JSON response
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
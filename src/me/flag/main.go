@@ -0,0 +1,3 @@
+package me_flag
+
+var Download bool // Download the media of saved or liked posts into download_flag.Directory
@@ -0,0 +1,56 @@
+package me_service
+
+import (
+	"fmt"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	"github.com/pterm/pterm"
+)
+
+// GetAll retrieves a list of the logged-in account page by page. Users have the same shape as
+// in the follow lists: entries of the blocked list, which name their ID user_id, get a pk too.
+func GetAll(
+	list List,
+	cookies map[string]string,
+	opts pagination_service.Options,
+) ([]map[string]interface{}, pagination_service.Summary, error) {
+	fetch := func(maxID string) (pagination_service.Page, error) {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching %s for maxID: %s", list.Name, maxID),
+		)
+		result, err := Get(list, cookies, maxID)
+		if err != nil {
+			return pagination_service.Page{}, err
+		}
+
+		batch, ok := result[list.Key].([]interface{})
+		if !ok {
+			// Invalid response format
+			return pagination_service.Page{}, fmt.Errorf("invalid response format; missing '%s' array for maxID=%s", list.Key, maxID)
+		}
+
+		// Convert []interface{} → []map[string]interface{}
+		var users []map[string]interface{}
+		for _, item := range batch {
+			user, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if _, ok := user["pk"]; !ok {
+				user["pk"] = user["user_id"]
+			}
+			users = append(users, user)
+		}
+
+		nextMaxID, _ := result["next_max_id"].(string)
+
+		return pagination_service.Page{
+			Items:     users,
+			NextMaxID: nextMaxID,
+		}, nil
+	}
+
+	return pagination_service.GetAll(fetch, opts)
+}
@@ -0,0 +1,20 @@
+package me_service
+
+// List is a list of accounts only visible to the logged-in account.
+type List struct {
+	Name string // Used in logs
+	URL  string
+	Key  string // Key of the users array in responses
+}
+
+// Lists of the logged-in account
+var (
+	// Accounts that requested to follow you
+	ListRequests = List{Name: "follow requests", URL: "https://www.instagram.com/api/v1/friendships/pending/", Key: "users"}
+	// Accounts you requested to follow
+	ListSentRequests = List{Name: "sent follow requests", URL: "https://www.instagram.com/api/v1/friendships/outgoing_requests/", Key: "users"}
+	// Your close friends
+	ListCloseFriends = List{Name: "close friends", URL: "https://www.instagram.com/api/v1/friendships/besties/", Key: "users"}
+	// Accounts you blocked
+	ListBlocked = List{Name: "blocked accounts", URL: "https://www.instagram.com/api/v1/users/blocked_list/", Key: "blocked_list"}
+)
@@ -0,0 +1,88 @@
+package me_service
+
+import (
+	"fmt"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	media_service "github.com/Rfluid/insta-tools/src/media/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+	"github.com/pterm/pterm"
+)
+
+// ListCollections lists the saved collections of the logged-in account
+var ListCollections = List{Name: "saved collections", URL: "https://www.instagram.com/api/v1/collections/list/", Key: "items"}
+
+// SavedMedia is a post saved by the logged-in account, with the names of the collections it was saved to.
+type SavedMedia struct {
+	media_service.Media
+	Collections []string `json:"collections"`
+}
+
+// SavedColumns are the fields shown when saved posts are rendered as a table.
+var SavedColumns = []string{"shortcode", "owner", "type", "taken_at", "collections", "caption"}
+
+// GetAllSaved retrieves the posts saved by the logged-in account page by page, naming the
+// collections of each post. When the collections cannot be listed, posts are kept without names.
+func GetAllSaved(
+	cookies map[string]string,
+	count int,
+	opts pagination_service.Options,
+) ([]SavedMedia, pagination_service.Summary, error) {
+	names, err := CollectionNames(cookies)
+	if err != nil {
+		pterm.DefaultLogger.Warn(fmt.Sprintf("Could not list saved collections: %s. Posts are listed without them", err))
+	}
+
+	fetch := func(maxID string) (pagination_service.Page, error) {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching saved media for maxID: %s", maxID),
+		)
+		result, err := media_service.GetSaved(cookies, count, maxID)
+		if err != nil {
+			return pagination_service.Page{}, err
+		}
+		return media_service.ItemsPage(result, "items")
+	}
+
+	items, summary, err := pagination_service.GetAll(fetch, opts)
+
+	saved := make([]SavedMedia, 0, len(items))
+	for _, item := range items {
+		post := SavedMedia{Media: media_service.FromItem(item), Collections: []string{}}
+		ids, _ := item["saved_collection_ids"].([]interface{})
+		for _, id := range ids {
+			if name, ok := names[value_service.String(id)]; ok {
+				post.Collections = append(post.Collections, name)
+			}
+		}
+		saved = append(saved, post)
+	}
+	return saved, summary, err
+}
+
+// CollectionNames returns the names of the saved collections of the logged-in account, keyed by collection ID.
+func CollectionNames(cookies map[string]string) (map[string]string, error) {
+	fetch := func(maxID string) (pagination_service.Page, error) {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching %s for maxID: %s", ListCollections.Name, maxID),
+		)
+		result, err := Get(ListCollections, cookies, maxID)
+		if err != nil {
+			return pagination_service.Page{}, err
+		}
+		return media_service.ItemsPage(result, ListCollections.Key)
+	}
+
+	collections, _, err := pagination_service.GetAll(fetch, pagination_service.Options{Threads: 1})
+
+	names := make(map[string]string, len(collections))
+	for _, collection := range collections {
+		id := value_service.String(collection["collection_id"])
+		name, _ := collection["collection_name"].(string)
+		names[id] = name
+	}
+	return names, err
+}
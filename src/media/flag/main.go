@@ -0,0 +1,3 @@
+package media_flag
+
+var Tab string // Tab of the hashtag and location feeds: top or recent
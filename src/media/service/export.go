@@ -0,0 +1,42 @@
+package media_service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ReadExport reads media written by the media command, either as a JSON array or as NDJSON.
+func ReadExport(path string) ([]Media, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read media export: %w", err)
+	}
+
+	content = bytes.TrimSpace(content)
+	if bytes.HasPrefix(content, []byte("[")) {
+		var media []Media
+		if err := json.Unmarshal(content, &media); err != nil {
+			return nil, fmt.Errorf("invalid media export: %w", err)
+		}
+		return media, nil
+	}
+
+	var media []Media
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, 16*1024*1024) // Captions and carousels make long lines
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var post Media
+		if err := json.Unmarshal(line, &post); err != nil {
+			return nil, fmt.Errorf("invalid media export: %w", err)
+		}
+		media = append(media, post)
+	}
+	return media, scanner.Err()
+}
@@ -0,0 +1,106 @@
+package media_service
+
+import (
+	"fmt"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+	"github.com/pterm/pterm"
+)
+
+// GetAll retrieves the user's feed page by page with the same machinery as the follow lists.
+func GetAll(
+	userID string,
+	cookies map[string]string,
+	count int,
+	opts pagination_service.Options,
+) ([]Media, pagination_service.Summary, error) {
+	return Collect(func(maxID string) (map[string]interface{}, error) {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching media of %s for maxID: %s", userID, maxID),
+		)
+		return Get(userID, cookies, count, maxID)
+	}, opts)
+}
+
+// GetAllTagged retrieves the posts the user is tagged in page by page.
+func GetAllTagged(
+	userID string,
+	cookies map[string]string,
+	count int,
+	opts pagination_service.Options,
+) ([]Media, pagination_service.Summary, error) {
+	return Collect(func(maxID string) (map[string]interface{}, error) {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching tagged media of %s for maxID: %s", userID, maxID),
+		)
+		return GetTagged(userID, cookies, count, maxID)
+	}, opts)
+}
+
+// GetAllLiked retrieves the posts liked by the logged-in account page by page.
+func GetAllLiked(
+	cookies map[string]string,
+	count int,
+	opts pagination_service.Options,
+) ([]Media, pagination_service.Summary, error) {
+	return Collect(func(maxID string) (map[string]interface{}, error) {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching liked media for maxID: %s", maxID),
+		)
+		return GetLiked(cookies, count, maxID)
+	}, opts)
+}
+
+// Collect pages through any feed whose responses hold an "items" array of media and a next_max_id cursor.
+func Collect(
+	get func(maxID string) (map[string]interface{}, error),
+	opts pagination_service.Options,
+) ([]Media, pagination_service.Summary, error) {
+	fetch := func(maxID string) (pagination_service.Page, error) {
+		result, err := get(maxID)
+		if err != nil {
+			return pagination_service.Page{}, err
+		}
+		return ItemsPage(result, "items")
+	}
+
+	items, summary, err := pagination_service.GetAll(fetch, opts)
+	return FromItems(items), summary, err
+}
+
+// ItemsPage converts a raw feed response into a page of the media stored under key.
+func ItemsPage(result map[string]interface{}, key string) (pagination_service.Page, error) {
+	batch, ok := result[key].([]interface{})
+	if !ok {
+		// Invalid response format
+		return pagination_service.Page{}, fmt.Errorf("invalid response format; missing '%s' array", key)
+	}
+
+	// Convert []interface{} → []map[string]interface{}
+	var items []map[string]interface{}
+	for _, item := range batch {
+		if m, ok := item.(map[string]interface{}); ok {
+			// Some feeds (e.g. saved posts) wrap each post as {"media": {...}}
+			if media, ok := m["media"].(map[string]interface{}); ok {
+				m = media
+			}
+			items = append(items, m)
+		}
+	}
+
+	// The cursor may be left over on the last page, so trust more_available when present
+	nextMaxID := value_service.String(result["next_max_id"])
+	if more, ok := result["more_available"].(bool); ok && !more {
+		nextMaxID = ""
+	}
+
+	return pagination_service.Page{
+		Items:     items,
+		NextMaxID: nextMaxID,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package media_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	ratelimit_service "github.com/Rfluid/insta-tools/src/ratelimit/service"
+	"github.com/pterm/pterm"
+)
+
+// GetInfo fetches a single post by its numeric media ID.
+func GetInfo(mediaID string, cookies map[string]string) (Media, error) {
+	// Construct the request URL with the media ID
+	url := fmt.Sprintf("https://www.instagram.com/api/v1/media/%s/info/", mediaID)
+
+	// Create a new request
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return Media{}, err
+	}
+
+	// Add headers to request
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	// Add cookies to the request
+	for key, value := range cookies {
+		req.AddCookie(&http.Cookie{Name: key, Value: value})
+	}
+
+	// Execute the request
+	resp, err := ratelimit_service.Do(req)
+	if err != nil {
+		return Media{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check if response is successful
+	if resp.StatusCode != http.StatusOK {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Error,
+			fmt.Sprintf("Error fetching media info. API status code is %v", resp.StatusCode),
+		)
+		return Media{}, fmt.Errorf("bad status code (%v) in API response", resp.StatusCode)
+	}
+
+	// Parse the JSON response
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return Media{}, err
+	}
+
+	page, err := ItemsPage(result, "items")
+	if err != nil {
+		return Media{}, err
+	}
+	if len(page.Items) == 0 {
+		return Media{}, fmt.Errorf("media %s not found", mediaID)
+	}
+	return FromItem(page.Items[0]), nil
+}
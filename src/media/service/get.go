@@ -0,0 +1,117 @@
+package media_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	ratelimit_service "github.com/Rfluid/insta-tools/src/ratelimit/service"
+	"github.com/pterm/pterm"
+)
+
+// Get makes a request to Instagram's API and returns a page of the user's feed as a map[string]interface{}
+func Get(
+	userID string,
+	cookies map[string]string,
+	count int,
+	maxID string,
+) (map[string]interface{}, error) {
+	// Construct the request URL with user ID
+	url := fmt.Sprintf("https://www.instagram.com/api/v1/feed/user/%s/", userID)
+
+	return getPage(url, cookies, count, maxID)
+}
+
+// GetTagged makes a request to Instagram's API and returns a page of the posts the user is tagged in as a map[string]interface{}
+func GetTagged(
+	userID string,
+	cookies map[string]string,
+	count int,
+	maxID string,
+) (map[string]interface{}, error) {
+	// Construct the request URL with user ID
+	url := fmt.Sprintf("https://www.instagram.com/api/v1/usertags/%s/feed/", userID)
+
+	return getPage(url, cookies, count, maxID)
+}
+
+// GetSaved makes a request to Instagram's API and returns a page of the posts saved by the logged-in account as a map[string]interface{}
+func GetSaved(
+	cookies map[string]string,
+	count int,
+	maxID string,
+) (map[string]interface{}, error) {
+	return getPage("https://www.instagram.com/api/v1/feed/saved/posts/", cookies, count, maxID)
+}
+
+// GetLiked makes a request to Instagram's API and returns a page of the posts liked by the logged-in account as a map[string]interface{}
+func GetLiked(
+	cookies map[string]string,
+	count int,
+	maxID string,
+) (map[string]interface{}, error) {
+	return getPage("https://www.instagram.com/api/v1/feed/liked/", cookies, count, maxID)
+}
+
+// getPage requests a page of a media feed at url. Feeds share the count/max_id pagination parameters.
+func getPage(
+	url string,
+	cookies map[string]string,
+	count int,
+	maxID string,
+) (map[string]interface{}, error) {
+	// Build query parameters
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add headers
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	// Add cookies to the request
+	for key, value := range cookies {
+		req.AddCookie(&http.Cookie{Name: key, Value: value})
+	}
+
+	// Add query parameters
+	query := req.URL.Query()
+	query.Add("count", fmt.Sprintf("%d", count))
+	if maxID != "" {
+		query.Add("max_id", maxID)
+	}
+	req.URL.RawQuery = query.Encode()
+
+	// Execute the request
+	resp, err := ratelimit_service.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check if response is successful
+	if resp.StatusCode != http.StatusOK {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Error,
+			fmt.Sprintf("Error fetching media. API status code is %v", resp.StatusCode),
+		)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return nil, err
+		}
+
+		return result, fmt.Errorf("bad status code (%v) in API response", resp.StatusCode)
+	}
+
+	// Parse the JSON response
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
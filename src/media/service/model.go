@@ -0,0 +1,114 @@
+package media_service
+
+import (
+	"time"
+
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+)
+
+// Media types
+const (
+	TypeImage    = "image"
+	TypeVideo    = "video"
+	TypeCarousel = "carousel"
+)
+
+// Media is a post, reel or carousel as returned by the feed endpoints.
+type Media struct {
+	ID           string    `json:"id"`
+	PK           string    `json:"pk"`
+	Shortcode    string    `json:"shortcode"`
+	Type         string    `json:"type"`
+	Owner        string    `json:"owner,omitempty"` // Username of the author
+	Caption      string    `json:"caption"`
+	TakenAt      time.Time `json:"taken_at"`
+	LikeCount    int       `json:"like_count"`
+	CommentCount int       `json:"comment_count"`
+	PlayCount    int       `json:"play_count,omitempty"` // Videos only
+	URL          string    `json:"url"`                  // Best quality image or video, empty for carousels
+	Children     []Media   `json:"children,omitempty"`   // Carousel items
+}
+
+// TableColumns are the fields shown when media are rendered as a table.
+var TableColumns = []string{"shortcode", "type", "taken_at", "like_count", "comment_count", "caption"}
+
+// TaggedColumns are the fields shown when posts of other accounts are rendered as a table.
+var TaggedColumns = []string{"shortcode", "owner", "type", "taken_at", "like_count", "caption"}
+
+// FromItem converts a raw feed item into a Media.
+func FromItem(item map[string]interface{}) Media {
+	media := Media{
+		ID:           value_service.String(item["id"]),
+		PK:           value_service.String(item["pk"]),
+		Shortcode:    value_service.String(item["code"]),
+		Type:         mediaType(item["media_type"]),
+		LikeCount:    value_service.Int(item["like_count"]),
+		CommentCount: value_service.Int(item["comment_count"]),
+		PlayCount:    value_service.Int(item["play_count"]),
+	}
+
+	if user, ok := item["user"].(map[string]interface{}); ok {
+		media.Owner = value_service.String(user["username"])
+	}
+	if caption, ok := item["caption"].(map[string]interface{}); ok {
+		media.Caption = value_service.String(caption["text"])
+	}
+	if takenAt, ok := item["taken_at"].(float64); ok {
+		media.TakenAt = time.Unix(int64(takenAt), 0).UTC()
+	}
+
+	switch media.Type {
+	case TypeVideo:
+		media.URL = firstURL(item["video_versions"])
+	case TypeImage:
+		if images, ok := item["image_versions2"].(map[string]interface{}); ok {
+			media.URL = firstURL(images["candidates"])
+		}
+	}
+
+	children, _ := item["carousel_media"].([]interface{})
+	for _, child := range children {
+		if childItem, ok := child.(map[string]interface{}); ok {
+			childMedia := FromItem(childItem)
+			if childMedia.TakenAt.IsZero() {
+				// Carousel items usually share the timestamp of their parent
+				childMedia.TakenAt = media.TakenAt
+			}
+			media.Children = append(media.Children, childMedia)
+		}
+	}
+
+	return media
+}
+
+// FromItems converts raw feed items into Media.
+func FromItems(items []map[string]interface{}) []Media {
+	media := make([]Media, 0, len(items))
+	for _, item := range items {
+		media = append(media, FromItem(item))
+	}
+	return media
+}
+
+func mediaType(value interface{}) string {
+	switch value_service.Int(value) {
+	case 1:
+		return TypeImage
+	case 2:
+		return TypeVideo
+	case 8:
+		return TypeCarousel
+	default:
+		return ""
+	}
+}
+
+// firstURL returns the URL of the first (highest quality) version in a list of versions.
+func firstURL(value interface{}) string {
+	versions, _ := value.([]interface{})
+	if len(versions) == 0 {
+		return ""
+	}
+	version, _ := versions[0].(map[string]interface{})
+	return value_service.String(version["url"])
+}
@@ -0,0 +1,212 @@
+package media_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	pagination_service "github.com/Rfluid/insta-tools/src/pagination/service"
+	ratelimit_service "github.com/Rfluid/insta-tools/src/ratelimit/service"
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+	"github.com/pterm/pterm"
+)
+
+// Tabs of the hashtag and location feeds
+const (
+	TabTop    = "top"
+	TabRecent = "recent"
+)
+
+// GetHashtag makes a request to Instagram's API and returns a page of a tab of a hashtag feed as a map[string]interface{}
+func GetHashtag(
+	tag string,
+	tab string,
+	cookies map[string]string,
+	maxID string,
+) (map[string]interface{}, error) {
+	// Construct the request URL with the hashtag
+	url := fmt.Sprintf("https://www.instagram.com/api/v1/tags/%s/sections/", url.PathEscape(tag))
+
+	return getSections(url, tab, cookies, maxID)
+}
+
+// GetLocation makes a request to Instagram's API and returns a page of a tab of a location feed as a map[string]interface{}
+func GetLocation(
+	locationID string,
+	tab string,
+	cookies map[string]string,
+	maxID string,
+) (map[string]interface{}, error) {
+	// Construct the request URL with the location ID
+	url := fmt.Sprintf("https://www.instagram.com/api/v1/locations/%s/sections/", locationID)
+
+	// Locations call their top tab "ranked"
+	if tab == TabTop {
+		tab = "ranked"
+	}
+	return getSections(url, tab, cookies, maxID)
+}
+
+// getSections requests a page of a sectioned feed at url. Unlike the other feeds, these are POST
+// requests, which Instagram only accepts with the CSRF token of the session.
+func getSections(
+	url string,
+	tab string,
+	cookies map[string]string,
+	maxID string,
+) (map[string]interface{}, error) {
+	// Build form parameters
+	body := strings.NewReader(sectionsForm(tab, maxID).Encode())
+
+	req, err := http.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add headers
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("content-type", "application/x-www-form-urlencoded")
+	req.Header.Set("x-csrftoken", cookies["csrftoken"])
+
+	// Add cookies to the request
+	for key, value := range cookies {
+		req.AddCookie(&http.Cookie{Name: key, Value: value})
+	}
+
+	// Execute the request
+	resp, err := ratelimit_service.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check if response is successful
+	if resp.StatusCode != http.StatusOK {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Error,
+			fmt.Sprintf("Error fetching media. API status code is %v", resp.StatusCode),
+		)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return nil, err
+		}
+
+		return result, fmt.Errorf("bad status code (%v) in API response", resp.StatusCode)
+	}
+
+	// Parse the JSON response
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAllHashtag retrieves a tab of a hashtag feed page by page.
+func GetAllHashtag(
+	tag string,
+	tab string,
+	cookies map[string]string,
+	opts pagination_service.Options,
+) ([]Media, pagination_service.Summary, error) {
+	return collectSections(func(maxID string) (map[string]interface{}, error) {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching %s media of #%s for maxID: %s", tab, tag, maxID),
+		)
+		return GetHashtag(tag, tab, cookies, maxID)
+	}, opts)
+}
+
+// GetAllLocation retrieves a tab of a location feed page by page.
+func GetAllLocation(
+	locationID string,
+	tab string,
+	cookies map[string]string,
+	opts pagination_service.Options,
+) ([]Media, pagination_service.Summary, error) {
+	return collectSections(func(maxID string) (map[string]interface{}, error) {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching %s media of location %s for maxID: %s", tab, locationID, maxID),
+		)
+		return GetLocation(locationID, tab, cookies, maxID)
+	}, opts)
+}
+
+// collectSections pages through a sectioned feed, where media are grouped into layout sections.
+func collectSections(
+	get func(maxID string) (map[string]interface{}, error),
+	opts pagination_service.Options,
+) ([]Media, pagination_service.Summary, error) {
+	fetch := func(maxID string) (pagination_service.Page, error) {
+		result, err := get(maxID)
+		if err != nil {
+			return pagination_service.Page{}, err
+		}
+		return SectionsPage(result)
+	}
+
+	items, summary, err := pagination_service.GetAll(fetch, opts)
+	return FromItems(items), summary, err
+}
+
+// SectionsPage converts a raw sectioned feed response into a page of media.
+func SectionsPage(result map[string]interface{}) (pagination_service.Page, error) {
+	sections, ok := result["sections"].([]interface{})
+	if !ok {
+		// Invalid response format
+		return pagination_service.Page{}, fmt.Errorf("invalid response format; missing 'sections' array")
+	}
+
+	var items []map[string]interface{}
+	for _, section := range sections {
+		sectionMap, _ := section.(map[string]interface{})
+		content, _ := sectionMap["layout_content"].(map[string]interface{})
+
+		// Grid sections hold "medias"; sections mixing reels hold them under one_by_two_item.clips
+		medias, _ := content["medias"].([]interface{})
+		if oneByTwo, ok := content["one_by_two_item"].(map[string]interface{}); ok {
+			clips, _ := oneByTwo["clips"].(map[string]interface{})
+			clipItems, _ := clips["items"].([]interface{})
+			medias = append(medias, clipItems...)
+		}
+
+		for _, entry := range medias {
+			entryMap, _ := entry.(map[string]interface{})
+			if media, ok := entryMap["media"].(map[string]interface{}); ok {
+				items = append(items, media)
+			}
+		}
+	}
+
+	// The cursor may be left over on the last page, so trust more_available when present
+	nextMaxID := value_service.String(result["next_max_id"])
+	if more, ok := result["more_available"].(bool); ok && !more {
+		nextMaxID = ""
+	}
+
+	return pagination_service.Page{
+		Items:     items,
+		NextMaxID: nextMaxID,
+	}, nil
+}
+
+// sectionsForm builds the form parameters of a sectioned feed request.
+func sectionsForm(tab string, maxID string) url.Values {
+	form := url.Values{}
+	form.Set("tab", tab)
+	form.Set("include_persistent", "0")
+	form.Set("surface", "grid")
+	if maxID != "" {
+		form.Set("max_id", maxID)
+	}
+	return form
+}
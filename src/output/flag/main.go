@@ -2,3 +2,22 @@ package output_flag
 
 // OutputPath stores the file path where results should be written
 var OutputPath string
+
+// Format stores the requested output format. Empty means "table on a terminal, JSON otherwise"
+var Format string
+
+// Template and TemplateFile store a Go text/template rendered once per record
+var (
+	Template     string
+	TemplateFile string
+)
+
+// Supported output formats
+const (
+	FormatJSON   = "json"
+	FormatTable  = "table"
+	FormatNDJSON = "ndjson" // One JSON record per line
+	FormatCSV    = "csv"
+	FormatSQLite = "sqlite" // Written to the -o database instead of printed
+	FormatHTML   = "html"   // Only rendered by dm export
+)
@@ -3,6 +3,8 @@ package output_service
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	log_service "github.com/Rfluid/insta-tools/src/log/service"
 	output_flag "github.com/Rfluid/insta-tools/src/output/flag"
@@ -53,3 +55,26 @@ func PrintConditionally(data string) {
 	)
 	fmt.Println(data)
 }
+
+// WriteAlongside writes data next to OutputPath, replacing its extension with suffix
+// (e.g. followers.json -> followers.summary.json). Without an output path, data goes to stderr
+// so it never mixes with the results printed on stdout.
+func WriteAlongside(suffix string, data string) error {
+	if output_flag.OutputPath == "" {
+		fmt.Fprintln(os.Stderr, data)
+		return nil
+	}
+
+	path := strings.TrimSuffix(output_flag.OutputPath, filepath.Ext(output_flag.OutputPath)) + suffix
+
+	log_service.LogConditionally(
+		pterm.DefaultLogger.Info,
+		fmt.Sprintf("Writing to file %s", path),
+	)
+
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return nil
+}
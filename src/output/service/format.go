@@ -0,0 +1,76 @@
+package output_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	output_flag "github.com/Rfluid/insta-tools/src/output/flag"
+	"github.com/pterm/pterm"
+	"golang.org/x/term"
+)
+
+// Format resolves the output format. An explicit --format wins; otherwise results printed
+// to a terminal are rendered as tables and everything else (pipes, -o files) stays JSON.
+func Format() string {
+	if output_flag.Format != "" {
+		return output_flag.Format
+	}
+	if output_flag.OutputPath == "" && term.IsTerminal(int(os.Stdout.Fd())) {
+		return output_flag.FormatTable
+	}
+	return output_flag.FormatJSON
+}
+
+// ValidateFormat checks that --format holds a supported value.
+func ValidateFormat() error {
+	switch output_flag.Format {
+	case "", output_flag.FormatJSON, output_flag.FormatTable, output_flag.FormatNDJSON, output_flag.FormatCSV, output_flag.FormatHTML:
+		return nil
+	case output_flag.FormatSQLite:
+		if output_flag.OutputPath == "" {
+			return fmt.Errorf("--format sqlite requires the database path in --output")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported format %q", output_flag.Format)
+	}
+}
+
+// Render converts value to the resolved output format. records are the individual records of
+// value (users, profiles...) rendered one by one when a template is set; table builds the table
+// representation. JSON is used when the format is JSON or the value has no table representation (nil table).
+// NDJSON and CSV are built from records, one line per record.
+// Values that cannot be shown as a table, such as API error bodies, fall back to JSON.
+func Render(value interface{}, records []map[string]interface{}, table func() (string, error)) (string, error) {
+	if recordTemplate != nil {
+		return RenderRecords(records)
+	}
+
+	switch Format() {
+	case output_flag.FormatNDJSON:
+		return RenderNDJSON(records)
+	case output_flag.FormatCSV:
+		return RenderCSV(records)
+	case output_flag.FormatHTML:
+		return "", fmt.Errorf("--format html is only supported by dm export")
+	}
+
+	if Format() == output_flag.FormatTable && table != nil {
+		result, err := table()
+		if err == nil {
+			return result, nil
+		}
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Warn,
+			fmt.Sprintf("Cannot render output as a table, falling back to JSON: %s", err),
+		)
+	}
+
+	resultJSON, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to convert data to JSON: %w", err)
+	}
+	return string(resultJSON), nil
+}
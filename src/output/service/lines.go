@@ -0,0 +1,66 @@
+package output_service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RenderNDJSON renders records as newline-delimited JSON, one record per line.
+func RenderNDJSON(records []map[string]interface{}) (string, error) {
+	lines := make([]string, 0, len(records))
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return "", fmt.Errorf("failed to convert record to JSON: %w", err)
+		}
+		lines = append(lines, string(line))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// RenderCSV renders records as CSV with a header made of the sorted union of their keys.
+// Nested objects and arrays are written as JSON.
+func RenderCSV(records []map[string]interface{}) (string, error) {
+	columnSet := make(map[string]bool)
+	for _, record := range records {
+		for key := range record {
+			columnSet[key] = true
+		}
+	}
+	columns := make([]string, 0, len(columnSet))
+	for column := range columnSet {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(columns); err != nil {
+		return "", err
+	}
+	for _, record := range records {
+		row := make([]string, len(columns))
+		for i, column := range columns {
+			switch value := record[column].(type) {
+			case map[string]interface{}, []interface{}:
+				cell, err := json.Marshal(value)
+				if err != nil {
+					return "", err
+				}
+				row[i] = string(cell)
+			default:
+				row[i] = str(value)
+			}
+		}
+		if err := writer.Write(row); err != nil {
+			return "", err
+		}
+	}
+	writer.Flush()
+
+	return strings.TrimSuffix(buf.String(), "\n"), writer.Error()
+}
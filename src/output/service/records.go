@@ -0,0 +1,47 @@
+package output_service
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PageRecords extracts the users of a single follow list page.
+func PageRecords(page map[string]interface{}) []map[string]interface{} {
+	batch, _ := page["users"].([]interface{})
+
+	var users []map[string]interface{}
+	for _, item := range batch {
+		if user, ok := item.(map[string]interface{}); ok {
+			users = append(users, user)
+		}
+	}
+	return users
+}
+
+// ProfileRecords extracts data.user from a profile returned by user_service.Get.
+func ProfileRecords(profile map[string]interface{}) []map[string]interface{} {
+	data, _ := profile["data"].(map[string]interface{})
+	user, ok := data["user"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []map[string]interface{}{user}
+}
+
+// ToRecords converts typed results (e.g. a slice of structs) into records through their JSON
+// representation, so filters, templates and tables see the same keys as the JSON output.
+func ToRecords(value interface{}) ([]map[string]interface{}, error) {
+	resultJSON, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert data to JSON: %w", err)
+	}
+
+	records := []map[string]interface{}{} // Empty results still render as [] rather than null
+	if err := json.Unmarshal(resultJSON, &records); err != nil {
+		return nil, fmt.Errorf("failed to convert data to records: %w", err)
+	}
+	if records == nil {
+		records = []map[string]interface{}{}
+	}
+	return records, nil
+}
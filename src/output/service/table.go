@@ -0,0 +1,140 @@
+package output_service
+
+import (
+	"fmt"
+	"strings"
+
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+	"github.com/pterm/pterm"
+)
+
+// UsersTable renders a follow list as a table followed by a footer with totals.
+// Extra footer lines (e.g. the pagination summary) are appended after the totals.
+func UsersTable(users []map[string]interface{}, footer ...string) (string, error) {
+	data := pterm.TableData{{"Username", "Full name", "ID", "Badges"}}
+	private, verified := 0, 0
+	for _, user := range users {
+		if isTrue(user["is_private"]) {
+			private++
+		}
+		if isTrue(user["is_verified"]) {
+			verified++
+		}
+		data = append(data, []string{
+			str(user["username"]),
+			str(user["full_name"]),
+			str(user["pk"]),
+			badges(user),
+		})
+	}
+
+	table, err := pterm.DefaultTable.WithHasHeader().WithData(data).Srender()
+	if err != nil {
+		return "", err
+	}
+
+	lines := append([]string{
+		table,
+		fmt.Sprintf("%d users (%d private, %d verified)", len(users), private, verified),
+	}, footer...)
+	return strings.Join(lines, "\n"), nil
+}
+
+// PageTable renders a single page of a follow list, with the cursor of the next page in the footer.
+func PageTable(page map[string]interface{}) (string, error) {
+	if _, ok := page["users"].([]interface{}); !ok {
+		return "", fmt.Errorf("invalid response format; missing 'users' array")
+	}
+	users := PageRecords(page)
+
+	var footer []string
+	if nextMaxID := str(page["next_max_id"]); nextMaxID != "" {
+		footer = append(footer, fmt.Sprintf("Next maxID: %s", nextMaxID))
+	}
+	return UsersTable(users, footer...)
+}
+
+// ProfileTable renders the profile returned by user_service.Get as a two-column table.
+func ProfileTable(profile map[string]interface{}) (string, error) {
+	records := ProfileRecords(profile)
+	if len(records) == 0 {
+		return "", fmt.Errorf("invalid response format; missing 'data.user' object")
+	}
+	user := records[0]
+
+	rows := pterm.TableData{
+		{"Username", str(user["username"])},
+		{"Full name", str(user["full_name"])},
+		{"ID", str(user["id"])},
+		{"Badges", badges(user)},
+		{"Followers", edgeCount(user, "edge_followed_by")},
+		{"Following", edgeCount(user, "edge_follow")},
+		{"Posts", edgeCount(user, "edge_owner_to_timeline_media")},
+		{"Biography", str(user["biography"])},
+		{"External URL", str(user["external_url"])},
+	}
+
+	return pterm.DefaultTable.WithData(rows).WithLeftAlignment().Srender()
+}
+
+// RecordsTable renders records as a table with one column per key in columns.
+// Long values such as captions are shortened to a single line to keep the table readable.
+func RecordsTable(columns []string, records []map[string]interface{}) (string, error) {
+	data := pterm.TableData{columns}
+	for _, record := range records {
+		row := make([]string, len(columns))
+		for i, column := range columns {
+			row[i] = shorten(str(record[column]))
+		}
+		data = append(data, row)
+	}
+
+	table, err := pterm.DefaultTable.WithHasHeader().WithData(data).Srender()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s\n%d rows", table, len(records)), nil
+}
+
+// badges renders the private/verified flags of a user.
+func badges(user map[string]interface{}) string {
+	var result []string
+	if isTrue(user["is_private"]) {
+		result = append(result, pterm.Yellow("private"))
+	}
+	if isTrue(user["is_verified"]) {
+		result = append(result, pterm.Cyan("verified"))
+	}
+	return strings.Join(result, " ")
+}
+
+// maxCellWidth is the maximum number of characters shown in a RecordsTable cell
+const maxCellWidth = 60
+
+func shorten(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if runes := []rune(value); len(runes) > maxCellWidth {
+		return string(runes[:maxCellWidth-1]) + "…"
+	}
+	return value
+}
+
+func edgeCount(user map[string]interface{}, edge string) string {
+	edgeData, _ := user[edge].(map[string]interface{})
+	return str(edgeData["count"])
+}
+
+func isTrue(value interface{}) bool {
+	b, _ := value.(bool)
+	return b
+}
+
+// str formats a JSON value for display; numbers decoded as float64 are printed without exponent.
+func str(value interface{}) string {
+	switch value.(type) {
+	case nil, string, float64:
+		return value_service.String(value)
+	default:
+		return fmt.Sprint(value)
+	}
+}
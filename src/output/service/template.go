@@ -0,0 +1,114 @@
+package output_service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	output_flag "github.com/Rfluid/insta-tools/src/output/flag"
+)
+
+// recordTemplate is parsed from --template or --template-file by LoadTemplate.
+var recordTemplate *template.Template
+
+// Functions available inside templates, in the spirit of docker --format
+var templateFuncs = template.FuncMap{
+	"json": func(value interface{}) (string, error) {
+		result, err := json.Marshal(value)
+		return string(result), err
+	},
+	"join": func(value interface{}, sep string) string {
+		items, _ := value.([]interface{})
+		parts := make([]string, len(items))
+		for i, item := range items {
+			parts[i] = str(item)
+		}
+		return strings.Join(parts, sep)
+	},
+	"lower": func(value interface{}) string {
+		return strings.ToLower(str(value))
+	},
+	"upper": func(value interface{}) string {
+		return strings.ToUpper(str(value))
+	},
+}
+
+// LoadTemplate parses the record template given by --template or --template-file, if any.
+func LoadTemplate() error {
+	text := output_flag.Template
+	if output_flag.TemplateFile != "" {
+		if text != "" {
+			return fmt.Errorf("--template and --template-file cannot be used together")
+		}
+		content, err := os.ReadFile(output_flag.TemplateFile)
+		if err != nil {
+			return fmt.Errorf("failed to read template file: %w", err)
+		}
+		text = strings.TrimSuffix(string(content), "\n")
+	} else {
+		// Allow escapes typed on the command line, e.g. '{{.Username}}\t{{.FullName}}'
+		text = strings.NewReplacer(`\t`, "\t", `\n`, "\n").Replace(text)
+	}
+	if text == "" {
+		return nil
+	}
+
+	tmpl, err := template.New("record").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	recordTemplate = tmpl
+	return nil
+}
+
+// RenderRecords executes the record template once per record, one record per line.
+// Keys are available both as returned by the API ({{.full_name}}) and in Go style ({{.FullName}}).
+func RenderRecords(records []map[string]interface{}) (string, error) {
+	var buf bytes.Buffer
+	for i, record := range records {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		if err := recordTemplate.Execute(&buf, withAliases(record)); err != nil {
+			return "", fmt.Errorf("failed to execute template: %w", err)
+		}
+	}
+	return buf.String(), nil
+}
+
+// withAliases copies a record adding a CamelCase alias for every snake_case key, recursively.
+func withAliases(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v)*2)
+		for key, item := range v {
+			item = withAliases(item)
+			result[key] = item
+			result[camelCase(key)] = item
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = withAliases(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// camelCase converts snake_case keys such as "full_name" to "FullName" and "pk" to "Pk".
+func camelCase(key string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(key, "_") {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
@@ -0,0 +1,11 @@
+package pagination_flag
+
+// Pagination flags shared by the commands that page through media, comments and likers
+var (
+	RetrieveAll    bool
+	SleepTime      int
+	KeepDuplicates bool // Keep items repeated across pages instead of dropping them by pk
+	MaxItems       int  // Stop paginating once this many items were collected
+	MaxPages       int  // Stop paginating once this many pages were fetched
+	Summary        bool // Write the run summary alongside the output
+)
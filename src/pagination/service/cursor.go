@@ -0,0 +1,31 @@
+package pagination_service
+
+import (
+	"strconv"
+	"strings"
+
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+)
+
+// skipSeparator joins the cursor of a page and the number of its items already returned, so a run
+// stopped by MaxItems in the middle of a page can be continued right after its last item
+const skipSeparator = ":skip="
+
+// joinCursor returns the cursor continuing after the first skip items of the page at cursor.
+func joinCursor(cursor string, skip int) string {
+	return cursor + skipSeparator + strconv.Itoa(skip)
+}
+
+// SplitCursor splits a cursor made by joinCursor into the API cursor and the number of items to skip.
+// Other cursors are returned as they are, with nothing to skip.
+func SplitCursor(maxID string) (string, int) {
+	i := strings.LastIndex(maxID, skipSeparator)
+	if i < 0 || !value_service.IsNumeric(maxID[i+len(skipSeparator):]) {
+		return maxID, 0
+	}
+	skip, err := strconv.Atoi(maxID[i+len(skipSeparator):])
+	if err != nil {
+		return maxID, 0
+	}
+	return maxID[:i], skip
+}
@@ -0,0 +1,220 @@
+package pagination_service
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+)
+
+// Page is a single page of items returned by a Fetcher.
+type Page struct {
+	Items     []map[string]interface{}
+	NextMaxID string
+}
+
+// Fetcher retrieves the page that starts at the given maxID.
+type Fetcher func(maxID string) (Page, error)
+
+type fetchResult struct {
+	MaxID     string // Cursor of the task, possibly made by joinCursor
+	Cursor    string // Cursor sent to the API
+	Skip      int    // Number of items of the page returned by a previous run
+	Items     []map[string]interface{}
+	NextMaxID string
+	Err       error
+}
+
+// GetAll follows the maxID cursor of fetch until exhaustion using a manager–worker pattern.
+// Failed pages do not discard what was already collected: every item fetched so far is returned
+// together with a Summary describing how complete the run was.
+// Instagram pagination occasionally repeats items across pages, so items are de-duplicated by pk
+// unless opts.KeepDuplicates is set.
+// When opts.MaxItems or opts.MaxPages stops the run early, Summary.NextMaxID holds the cursor to continue from.
+// If opts.MaxItems is reached in the middle of a page, that cursor also tells how many items of the page
+// to skip, so continuing from it returns the items right after the last one returned.
+func GetAll(
+	fetch Fetcher,
+	opts Options,
+) ([]map[string]interface{}, Summary, error) {
+	// ------------------------------------------------------------------------
+	// Data structures
+	// ------------------------------------------------------------------------
+	var (
+		allItems []map[string]interface{}
+		summary  Summary
+		errs     []error
+		seen     = make(map[string]bool) // pks already collected
+		dataMu   sync.Mutex              // protects allItems, summary, errs and seen
+	)
+
+	// Channel of tasks, where each task is "fetch the next page for this maxID"
+	taskChan := make(chan string)
+
+	// Channel of results, each worker sends back a fetchResult
+	resultsChan := make(chan fetchResult)
+
+	var wg sync.WaitGroup // WaitGroup for workers
+
+	// ------------------------------------------------------------------------
+	// Worker pool
+	// ------------------------------------------------------------------------
+	worker := func() {
+		defer wg.Done()
+
+		for maxID := range taskChan {
+			cursor, skip := SplitCursor(maxID)
+			page, err := fetch(cursor)
+			if err != nil {
+				// Send error back, keeping the cursor so the page can be retried later
+				resultsChan <- fetchResult{
+					MaxID: maxID,
+					Err:   err,
+				}
+				continue
+			}
+
+			// Optional rate limiting
+			time.Sleep(time.Duration(opts.SleepTime) * time.Second)
+
+			// Send success result
+			resultsChan <- fetchResult{
+				MaxID:     maxID,
+				Cursor:    cursor,
+				Skip:      skip,
+				Items:     page.Items,
+				NextMaxID: page.NextMaxID,
+			}
+		}
+	}
+
+	// Spin up N workers
+	threads := max(opts.Threads, 1)
+	for i := 0; i < threads; i++ {
+		wg.Add(1)
+		go worker()
+	}
+
+	// ------------------------------------------------------------------------
+	// Manager goroutine
+	// ------------------------------------------------------------------------
+	// The manager sends tasks (maxIDs) to workers, *and* collects results.
+	// We track how many tasks are "in flight" so we know when to stop.
+	// Because tasks can generate new tasks (i.e. nextMaxID), we dynamically
+	// feed them back into the worker pool.
+	managerWg := sync.WaitGroup{}
+	managerWg.Add(1)
+
+	go func() {
+		defer managerWg.Done()
+
+		// Start by feeding the initial maxID
+		inFlight := 1
+		taskChan <- opts.InitialMaxID
+
+		// Keep reading results until inFlight == 0
+		for inFlight > 0 {
+			res, ok := <-resultsChan
+			if !ok {
+				// If resultsChan is closed unexpectedly, we break
+				break
+			}
+
+			// Decrement in-flight count for the completed task
+			inFlight--
+
+			dataMu.Lock()
+			if res.Err != nil {
+				// Record every failed page so the caller knows where the export has holes
+				summary.PagesFailed = append(summary.PagesFailed, PageError{
+					MaxID: res.MaxID,
+					Error: res.Err.Error(),
+				})
+				errs = append(errs, fmt.Errorf("page with maxID=%q: %w", res.MaxID, res.Err))
+				dataMu.Unlock()
+				continue
+			}
+
+			// Append the returned items, up to opts.MaxItems
+			summary.PagesFetched++
+			before := len(allItems)
+			last := -1 // Index of the item that reached opts.MaxItems
+			for i, item := range res.Items {
+				if i < res.Skip {
+					// Returned by the run that stopped in the middle of this page
+					continue
+				}
+				key, ok := pk(item)
+				if ok && !opts.KeepDuplicates {
+					if seen[key] {
+						summary.DuplicatesRemoved++
+						continue
+					}
+					seen[key] = true
+				}
+				allItems = append(allItems, item)
+				if opts.MaxItems > 0 && len(allItems) >= opts.MaxItems {
+					last = i
+					break
+				}
+			}
+
+			// Stop early when a limit is reached and remember where to continue from:
+			// right after the last item returned, which may be in the middle of this page
+			limited := last >= 0 || (opts.MaxPages > 0 && summary.PagesFetched >= opts.MaxPages)
+			if last >= 0 && last < len(res.Items)-1 {
+				summary.NextMaxID = joinCursor(res.Cursor, last+1)
+			} else if limited {
+				summary.NextMaxID = res.NextMaxID
+			}
+			added := len(allItems) - before
+			dataMu.Unlock()
+
+			if opts.OnPage != nil {
+				opts.OnPage(added)
+			}
+
+			// A page pointing back to itself would be fetched forever
+			if res.NextMaxID != "" && res.NextMaxID == res.Cursor {
+				dataMu.Lock()
+				errs = append(errs, fmt.Errorf("page with maxID=%q returned its own cursor as the next one", res.Cursor))
+				dataMu.Unlock()
+				continue
+			}
+
+			// If there's a nextMaxID, enqueue a new task
+			if res.NextMaxID != "" && !limited {
+				inFlight++
+				taskChan <- res.NextMaxID
+			}
+		}
+
+		// No more tasks will be generated -> close the worker channel
+		close(taskChan)
+	}()
+
+	// ------------------------------------------------------------------------
+	// Wait for workers to finish
+	// ------------------------------------------------------------------------
+	wg.Wait()
+
+	// When all workers are done reading from taskChan, they exit
+	// so no one else will write to resultsChan. Now we can close resultsChan.
+	close(resultsChan)
+
+	// The manager goroutine might still be waiting in the for-loop above,
+	// but it will break out once resultsChan is closed. Wait for manager to finish.
+	managerWg.Wait()
+
+	summary.ItemsCollected = len(allItems)
+
+	return allItems, summary, errors.Join(errs...)
+}
+
+// pk returns the primary key of an item as a string, whether the API sent it as a string or a number.
+func pk(item map[string]interface{}) (string, bool) {
+	key := value_service.String(item["pk"])
+	return key, key != ""
+}
@@ -0,0 +1,198 @@
+package pagination_service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+// fakePages serves total items with pk 0..total-1 in pages of size items, using the index of
+// the first item of a page as its cursor ("" for the first page).
+func fakePages(total int, size int) Fetcher {
+	return func(maxID string) (Page, error) {
+		start := 0
+		if maxID != "" {
+			var err error
+			if start, err = strconv.Atoi(maxID); err != nil {
+				return Page{}, err
+			}
+		}
+		var page Page
+		for i := start; i < min(start+size, total); i++ {
+			page.Items = append(page.Items, map[string]interface{}{"pk": strconv.Itoa(i)})
+		}
+		if start+size < total {
+			page.NextMaxID = strconv.Itoa(start + size)
+		}
+		return page, nil
+	}
+}
+
+func pks(items []map[string]interface{}) []string {
+	keys := make([]string, 0, len(items))
+	for _, item := range items {
+		keys = append(keys, fmt.Sprint(item["pk"]))
+	}
+	return keys
+}
+
+func TestGetAllLimits(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      Options
+		wantItems int
+		wantNext  string
+	}{
+		{"no limit", Options{}, 25, ""},
+		{"max items smaller than a page", Options{MaxItems: 3}, 3, ":skip=3"},
+		{"max items on page boundary", Options{MaxItems: 20}, 20, "20"},
+		{"max items mid later page", Options{MaxItems: 15}, 15, "10:skip=5"},
+		{"max items beyond total", Options{MaxItems: 100}, 25, ""},
+		{"max items mid last page", Options{MaxItems: 22}, 22, "20:skip=2"},
+		{"max items on last item", Options{MaxItems: 25}, 25, ""},
+		{"max pages", Options{MaxPages: 2}, 20, "20"},
+		{"max items from cursor", Options{InitialMaxID: "10", MaxItems: 5}, 5, "10:skip=5"},
+		{"max items from mid-page cursor", Options{InitialMaxID: "10:skip=5", MaxItems: 3}, 3, "10:skip=8"},
+		{"rest of page from mid-page cursor", Options{InitialMaxID: "10:skip=5", MaxPages: 1}, 5, "20"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			items, summary, err := GetAll(fakePages(25, 10), test.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(items) != test.wantItems {
+				t.Errorf("got %d items, want %d", len(items), test.wantItems)
+			}
+			if summary.NextMaxID != test.wantNext {
+				t.Errorf("got next maxID %q, want %q", summary.NextMaxID, test.wantNext)
+			}
+			if summary.ItemsCollected != len(items) {
+				t.Errorf("got %d items collected, want %d", summary.ItemsCollected, len(items))
+			}
+		})
+	}
+}
+
+// TestGetAllResume checks that continuing from the printed cursor returns every item exactly once.
+func TestGetAllResume(t *testing.T) {
+	for _, limit := range []int{1, 3, 10, 15, 20} {
+		t.Run(strconv.Itoa(limit), func(t *testing.T) {
+			var all []string
+			opts := Options{MaxItems: limit}
+			for run := 0; ; run++ {
+				if run > 25 {
+					t.Fatal("runs do not make progress")
+				}
+				items, summary, err := GetAll(fakePages(25, 10), opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := min(limit, 25-len(all)); len(items) != want {
+					t.Fatalf("run %d got %d items, want %d", run, len(items), want)
+				}
+				all = append(all, pks(items)...)
+				if summary.NextMaxID == "" {
+					break
+				}
+				opts.InitialMaxID = summary.NextMaxID
+			}
+			if len(all) != 25 {
+				t.Fatalf("got %d items over all runs, want 25", len(all))
+			}
+			for i, key := range all {
+				if key != strconv.Itoa(i) {
+					t.Fatalf("got pk %s at position %d, want %d", key, i, i)
+				}
+			}
+		})
+	}
+}
+
+func TestGetAllDuplicates(t *testing.T) {
+	fetch := func(maxID string) (Page, error) {
+		if maxID == "" {
+			return Page{Items: []map[string]interface{}{{"pk": "1"}, {"pk": float64(2)}}, NextMaxID: "next"}, nil
+		}
+		return Page{Items: []map[string]interface{}{{"pk": "2"}, {"pk": "3"}}}, nil
+	}
+
+	items, summary, err := GetAll(fetch, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 3 || summary.DuplicatesRemoved != 1 {
+		t.Errorf("got %v with %d duplicates removed, want 3 items and 1 duplicate", pks(items), summary.DuplicatesRemoved)
+	}
+
+	items, _, _ = GetAll(fetch, Options{KeepDuplicates: true})
+	if len(items) != 4 {
+		t.Errorf("got %d items with duplicates kept, want 4", len(items))
+	}
+}
+
+func TestGetAllFailedPage(t *testing.T) {
+	fetch := func(maxID string) (Page, error) {
+		if maxID == "" {
+			return Page{Items: []map[string]interface{}{{"pk": "1"}}, NextMaxID: "broken"}, nil
+		}
+		return Page{}, errors.New("rate limited")
+	}
+
+	items, summary, err := GetAll(fetch, Options{})
+	if err == nil {
+		t.Fatal("expected an error for the failed page")
+	}
+	if len(items) != 1 {
+		t.Errorf("got %d items, want the 1 item fetched before the failure", len(items))
+	}
+	if len(summary.PagesFailed) != 1 || summary.PagesFailed[0].MaxID != "broken" {
+		t.Errorf("got failed pages %+v, want the page with maxID broken", summary.PagesFailed)
+	}
+}
+
+func TestGetAllRepeatedCursor(t *testing.T) {
+	calls := 0
+	fetch := func(maxID string) (Page, error) {
+		calls++
+		if calls > 3 {
+			return Page{}, errors.New("the same page keeps being fetched")
+		}
+		if maxID == "" {
+			return Page{Items: []map[string]interface{}{{"pk": "1"}}, NextMaxID: "stuck"}, nil
+		}
+		return Page{Items: []map[string]interface{}{{"pk": "2"}}, NextMaxID: "stuck"}, nil
+	}
+
+	items, _, err := GetAll(fetch, Options{})
+	if err == nil {
+		t.Error("expected an error for the page returning its own cursor")
+	}
+	if len(items) != 2 || calls != 2 {
+		t.Errorf("got %d items in %d calls, want 2 items in 2 calls", len(items), calls)
+	}
+}
+
+func TestSplitCursor(t *testing.T) {
+	tests := []struct {
+		maxID      string
+		wantCursor string
+		wantSkip   int
+	}{
+		{"", "", 0},
+		{"QVFCbz", "QVFCbz", 0},
+		{":skip=3", "", 3},
+		{"QVFCbz:skip=12", "QVFCbz", 12},
+		{`{"cursor":"a:skip=x"}`, `{"cursor":"a:skip=x"}`, 0},
+	}
+	for _, test := range tests {
+		cursor, skip := SplitCursor(test.maxID)
+		if cursor != test.wantCursor || skip != test.wantSkip {
+			t.Errorf("SplitCursor(%q) = %q, %d, want %q, %d", test.maxID, cursor, skip, test.wantCursor, test.wantSkip)
+		}
+		if test.wantSkip > 0 && joinCursor(cursor, skip) != test.maxID {
+			t.Errorf("joinCursor(%q, %d) = %q, want %q", cursor, skip, joinCursor(cursor, skip), test.maxID)
+		}
+	}
+}
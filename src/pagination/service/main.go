@@ -0,0 +1,34 @@
+package pagination_service
+
+import (
+	pagination_flag "github.com/Rfluid/insta-tools/src/pagination/flag"
+	thread_flag "github.com/Rfluid/insta-tools/src/thread/flag"
+)
+
+// Options controls how GetAll walks through the pages.
+type Options struct {
+	InitialMaxID   string          // Cursor of the first page to fetch
+	Threads        int             // Number of workers
+	SleepTime      int             // Seconds to wait between API requests
+	KeepDuplicates bool            // Keep items repeated across pages instead of dropping them by pk
+	MaxItems       int             // Stop once this many items were collected (0 means no limit)
+	MaxPages       int             // Stop once this many pages were fetched (0 means no limit)
+	OnPage         func(items int) // Called after every fetched page with the number of items it added
+}
+
+// FlagOptions builds the Options of commands using the shared pagination flags.
+// A single page is fetched unless --all or one of the limits is set.
+func FlagOptions(initialMaxID string) Options {
+	opts := Options{
+		InitialMaxID:   initialMaxID,
+		Threads:        thread_flag.APIThreads,
+		SleepTime:      pagination_flag.SleepTime,
+		KeepDuplicates: pagination_flag.KeepDuplicates,
+		MaxItems:       pagination_flag.MaxItems,
+		MaxPages:       pagination_flag.MaxPages,
+	}
+	if !pagination_flag.RetrieveAll && opts.MaxItems == 0 && opts.MaxPages == 0 {
+		opts.MaxPages = 1
+	}
+	return opts
+}
@@ -0,0 +1,43 @@
+package pagination_service
+
+import "fmt"
+
+// PageError describes a page that could not be fetched.
+type PageError struct {
+	MaxID string `json:"max_id"`
+	Error string `json:"error"`
+}
+
+// Summary reports how complete a paginated run was.
+type Summary struct {
+	PagesFetched      int         `json:"pages_fetched"`
+	PagesFailed       []PageError `json:"pages_failed"`
+	ItemsCollected    int         `json:"items_collected"`
+	ItemsExpected     int         `json:"items_expected,omitempty"` // Total announced by the profile, when known
+	DuplicatesRemoved int         `json:"duplicates_removed"`
+	NextMaxID         string      `json:"next_max_id,omitempty"` // Cursor to continue from when a limit stopped the run, ending in ":skip=<n>" in the middle of a page
+}
+
+// Coverage returns the collected/expected ratio as a percentage, or -1 if the expected total is unknown.
+func (s Summary) Coverage() float64 {
+	if s.ItemsExpected <= 0 {
+		return -1
+	}
+	return float64(s.ItemsCollected) / float64(s.ItemsExpected) * 100
+}
+
+// String renders the summary as a single human-readable line.
+func (s Summary) String() string {
+	msg := fmt.Sprintf(
+		"Pages fetched: %d, pages failed: %d, items collected: %d",
+		s.PagesFetched, len(s.PagesFailed), s.ItemsCollected,
+	)
+	if coverage := s.Coverage(); coverage >= 0 {
+		msg += fmt.Sprintf(" of %d (%.1f%%)", s.ItemsExpected, coverage)
+	}
+	msg += fmt.Sprintf(", duplicates removed: %d", s.DuplicatesRemoved)
+	if s.NextMaxID != "" {
+		msg += fmt.Sprintf(", next maxID: %s", s.NextMaxID)
+	}
+	return msg
+}
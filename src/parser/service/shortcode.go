@@ -0,0 +1,78 @@
+package parser_service
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+)
+
+// Shortcodes are media IDs written in base 64 with this alphabet
+const shortcodeAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+
+// Only the first shortcodeLength characters of a shortcode encode the media ID; private posts
+// have longer shortcodes whose remaining characters are a token
+const shortcodeLength = 11
+
+// ShortcodeToID converts a post shortcode (the part after /p/ in its URL) into its numeric media ID.
+func ShortcodeToID(shortcode string) (string, error) {
+	if shortcode == "" || strings.Trim(shortcode, shortcodeAlphabet) != "" {
+		return "", fmt.Errorf("invalid shortcode %q", shortcode)
+	}
+
+	id := new(big.Int)
+	base := big.NewInt(64)
+	for _, r := range shortcode[:min(len(shortcode), shortcodeLength)] {
+		id.Mul(id, base)
+		id.Add(id, big.NewInt(int64(strings.IndexRune(shortcodeAlphabet, r))))
+	}
+	return id.String(), nil
+}
+
+// IDToShortcode converts a numeric media ID (optionally in the "<media>_<owner>" form) into its shortcode.
+func IDToShortcode(mediaID string) (string, error) {
+	digits, _, _ := strings.Cut(mediaID, "_")
+	id, ok := new(big.Int).SetString(digits, 10)
+	if !ok || id.Sign() < 0 {
+		return "", fmt.Errorf("invalid media ID %q", mediaID)
+	}
+	if id.Sign() == 0 {
+		return string(shortcodeAlphabet[0]), nil
+	}
+
+	var (
+		shortcode []byte
+		base      = big.NewInt(64)
+		digit     = new(big.Int)
+	)
+	for id.Sign() > 0 {
+		id.DivMod(id, base, digit)
+		shortcode = append(shortcode, shortcodeAlphabet[digit.Int64()])
+	}
+
+	// Digits were produced from the least significant one
+	for i, j := 0, len(shortcode)-1; i < j; i, j = i+1, j-1 {
+		shortcode[i], shortcode[j] = shortcode[j], shortcode[i]
+	}
+	return string(shortcode), nil
+}
+
+// MediaID returns the numeric media ID of a post given as a post URL, a shortcode or a media ID.
+// Media IDs in the "<media>_<owner>" form returned by some endpoints are accepted as well.
+func MediaID(post string) (string, error) {
+	post = strings.TrimSpace(post)
+	if isURL(post) {
+		shortcode, err := Shortcode(post)
+		if err != nil {
+			return "", err
+		}
+		return ShortcodeToID(shortcode)
+	}
+
+	mediaID, _, _ := strings.Cut(post, "_")
+	if value_service.IsNumeric(mediaID) {
+		return mediaID, nil
+	}
+	return ShortcodeToID(post)
+}
@@ -0,0 +1,106 @@
+package parser_service
+
+import "testing"
+
+func TestShortcodeRoundTrip(t *testing.T) {
+	for _, mediaID := range []string{
+		"0",
+		"1",
+		"64",
+		"3141592653589793238",
+		"2830309326452423034",
+		"9223372036854775807",
+	} {
+		shortcode, err := IDToShortcode(mediaID)
+		if err != nil {
+			t.Fatalf("IDToShortcode(%q): %s", mediaID, err)
+		}
+		got, err := ShortcodeToID(shortcode)
+		if err != nil {
+			t.Fatalf("ShortcodeToID(%q): %s", shortcode, err)
+		}
+		if got != mediaID {
+			t.Errorf("ShortcodeToID(IDToShortcode(%q)) = %q", mediaID, got)
+		}
+	}
+}
+
+func TestShortcodeToID(t *testing.T) {
+	tests := []struct {
+		shortcode string
+		want      string
+	}{
+		{"A", "0"},
+		{"B", "1"},
+		{"BA", "64"},
+		{"_", "63"},
+		// Private posts append a token to the 11 characters encoding the media ID
+		{"CdLsZ-Avh9_", "2831552089350348671"},
+		{"CdLsZ-Avh9_AbCdEfGhIjKlMnOpQrStUvWxYz0123-_", "2831552089350348671"},
+	}
+	for _, test := range tests {
+		got, err := ShortcodeToID(test.shortcode)
+		if err != nil {
+			t.Fatalf("ShortcodeToID(%q): %s", test.shortcode, err)
+		}
+		if got != test.want {
+			t.Errorf("ShortcodeToID(%q) = %q, want %q", test.shortcode, got, test.want)
+		}
+	}
+
+	for _, shortcode := range []string{"", "abc!", "CdLsZ-Avh9_AbC=ef"} {
+		if _, err := ShortcodeToID(shortcode); err == nil {
+			t.Errorf("ShortcodeToID(%q) should fail", shortcode)
+		}
+	}
+}
+
+func TestIDToShortcode(t *testing.T) {
+	tests := []struct {
+		mediaID string
+		want    string
+	}{
+		{"0", "A"},
+		{"64", "BA"},
+		{"2831552089350348671", "CdLsZ-Avh9_"},
+		{"2831552089350348671_25025320", "CdLsZ-Avh9_"},
+	}
+	for _, test := range tests {
+		got, err := IDToShortcode(test.mediaID)
+		if err != nil {
+			t.Fatalf("IDToShortcode(%q): %s", test.mediaID, err)
+		}
+		if got != test.want {
+			t.Errorf("IDToShortcode(%q) = %q, want %q", test.mediaID, got, test.want)
+		}
+	}
+
+	for _, mediaID := range []string{"", "abc", "-1", "_25025320"} {
+		if _, err := IDToShortcode(mediaID); err == nil {
+			t.Errorf("IDToShortcode(%q) should fail", mediaID)
+		}
+	}
+}
+
+func TestMediaID(t *testing.T) {
+	tests := []struct {
+		post string
+		want string
+	}{
+		{"2831552089350348671", "2831552089350348671"},
+		{"2831552089350348671_25025320", "2831552089350348671"},
+		{"CdLsZ-Avh9_", "2831552089350348671"},
+		{"https://www.instagram.com/p/CdLsZ-Avh9_/", "2831552089350348671"},
+		{"https://www.instagram.com/p/CdLsZ-Avh9_AbCdEfGhIjKlMnOpQrStUvWxYz0123-_/", "2831552089350348671"},
+		{"https://www.instagram.com/reel/CdLsZ-Avh9_/?igsh=abc", "2831552089350348671"},
+	}
+	for _, test := range tests {
+		got, err := MediaID(test.post)
+		if err != nil {
+			t.Fatalf("MediaID(%q): %s", test.post, err)
+		}
+		if got != test.want {
+			t.Errorf("MediaID(%q) = %q, want %q", test.post, got, test.want)
+		}
+	}
+}
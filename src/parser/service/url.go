@@ -0,0 +1,150 @@
+package parser_service
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+)
+
+// Path prefixes of post URLs, e.g. /p/<shortcode>/ or /reel/<shortcode>/
+var postPrefixes = map[string]bool{
+	"p":     true,
+	"reel":  true,
+	"reels": true,
+	"tv":    true,
+}
+
+// First path segments that are not usernames
+var reservedPaths = map[string]bool{
+	"p":        true,
+	"reel":     true,
+	"reels":    true,
+	"tv":       true,
+	"stories":  true,
+	"explore":  true,
+	"accounts": true,
+	"direct":   true,
+}
+
+// Username returns the username given either as is (with or without a leading @) or as a
+// profile URL such as https://www.instagram.com/<username>/ or a story URL.
+func Username(user string) (string, error) {
+	user = strings.TrimSpace(user)
+	if !isURL(user) {
+		user = strings.TrimSpace(strings.TrimPrefix(user, "@"))
+		if user == "" {
+			return "", fmt.Errorf("empty username")
+		}
+		return user, nil
+	}
+
+	segments, err := pathSegments(user)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case len(segments) >= 2 && segments[0] == "stories":
+		// /stories/<username>/<story>/
+		return segments[1], nil
+	case len(segments) >= 1 && !reservedPaths[segments[0]]:
+		return segments[0], nil
+	}
+	return "", fmt.Errorf("%s is not a profile URL", user)
+}
+
+// Shortcode returns the shortcode of a post given either as is or as a post URL such as
+// https://www.instagram.com/p/<shortcode>/, /reel/<shortcode>/ or /<username>/p/<shortcode>/.
+func Shortcode(post string) (string, error) {
+	post = strings.TrimSpace(post)
+	if !isURL(post) {
+		if post == "" {
+			return "", fmt.Errorf("empty shortcode")
+		}
+		return post, nil
+	}
+
+	segments, err := pathSegments(post)
+	if err != nil {
+		return "", err
+	}
+	for i := 0; i+1 < len(segments) && i < 2; i++ {
+		if postPrefixes[segments[i]] {
+			return segments[i+1], nil
+		}
+	}
+	return "", fmt.Errorf("%s is not a post URL", post)
+}
+
+// Hashtag returns the hashtag given either as is (with or without a leading #) or as a hashtag URL
+// such as https://www.instagram.com/explore/tags/<tag>/.
+func Hashtag(tag string) (string, error) {
+	tag = strings.TrimSpace(tag)
+	if !isURL(tag) {
+		tag = strings.TrimPrefix(tag, "#")
+		if tag == "" {
+			return "", fmt.Errorf("empty hashtag")
+		}
+		return tag, nil
+	}
+
+	segments, err := pathSegments(tag)
+	if err != nil {
+		return "", err
+	}
+	if len(segments) >= 3 && segments[0] == "explore" && segments[1] == "tags" {
+		return segments[2], nil
+	}
+	return "", fmt.Errorf("%s is not a hashtag URL", tag)
+}
+
+// LocationID returns the numeric ID of a location given either as is or as a location URL
+// such as https://www.instagram.com/explore/locations/<id>/<name>/.
+func LocationID(location string) (string, error) {
+	location = strings.TrimSpace(location)
+	if isURL(location) {
+		segments, err := pathSegments(location)
+		if err != nil {
+			return "", err
+		}
+		if len(segments) < 3 || segments[0] != "explore" || segments[1] != "locations" {
+			return "", fmt.Errorf("%s is not a location URL", location)
+		}
+		location = segments[2]
+	}
+
+	if !value_service.IsNumeric(location) {
+		return "", fmt.Errorf("invalid location ID %q", location)
+	}
+	return location, nil
+}
+
+// isURL reports whether value looks like an Instagram URL rather than a bare name or ID.
+func isURL(value string) bool {
+	return strings.Contains(value, "instagram.com/") || strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://")
+}
+
+// pathSegments parses an Instagram URL, with or without scheme, and returns the non-empty segments of its path.
+func pathSegments(rawURL string) ([]string, error) {
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL %s: %w", rawURL, err)
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if host != "instagram.com" && !strings.HasSuffix(host, ".instagram.com") {
+		return nil, fmt.Errorf("%s is not an Instagram URL", rawURL)
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(parsed.Path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments, nil
+}
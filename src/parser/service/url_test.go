@@ -0,0 +1,156 @@
+package parser_service
+
+import "testing"
+
+func TestUsername(t *testing.T) {
+	tests := []struct {
+		user string
+		want string
+	}{
+		{"zuck", "zuck"},
+		{"@zuck", "zuck"},
+		{"  zuck\t", "zuck"},
+		{"314216", "314216"},
+		{"https://www.instagram.com/zuck/", "zuck"},
+		{"https://instagram.com/zuck", "zuck"},
+		{"instagram.com/zuck/?igsh=abc", "zuck"},
+		{"https://www.instagram.com/zuck/tagged/", "zuck"},
+		{"https://www.instagram.com/zuck/reels/", "zuck"},
+		{"https://www.instagram.com/stories/zuck/3141592653589793238/", "zuck"},
+		{"https://m.instagram.com/zuck/", "zuck"},
+	}
+	for _, test := range tests {
+		got, err := Username(test.user)
+		if err != nil {
+			t.Errorf("Username(%q): %s", test.user, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("Username(%q) = %q, want %q", test.user, got, test.want)
+		}
+	}
+
+	for _, user := range []string{
+		"",
+		"  ",
+		"@",
+		"https://www.instagram.com/",
+		"https://www.instagram.com/p/CdLsZ-Avh9_/",
+		"https://www.instagram.com/reel/CdLsZ-Avh9_/",
+		"https://www.instagram.com/explore/tags/coffee/",
+		"https://www.instagram.com/accounts/login/",
+		"https://www.instagram.com/direct/inbox/",
+		"https://www.instagram.com/stories/",
+		"https://example.com/zuck/",
+	} {
+		if got, err := Username(user); err == nil {
+			t.Errorf("Username(%q) = %q, want an error", user, got)
+		}
+	}
+}
+
+func TestShortcode(t *testing.T) {
+	tests := []struct {
+		post string
+		want string
+	}{
+		{"CdLsZ-Avh9_", "CdLsZ-Avh9_"},
+		{"https://www.instagram.com/p/CdLsZ-Avh9_/", "CdLsZ-Avh9_"},
+		{"https://www.instagram.com/p/CdLsZ-Avh9_/?img_index=2", "CdLsZ-Avh9_"},
+		{"https://www.instagram.com/reel/CdLsZ-Avh9_/", "CdLsZ-Avh9_"},
+		{"https://www.instagram.com/reels/CdLsZ-Avh9_/", "CdLsZ-Avh9_"},
+		{"https://www.instagram.com/tv/CdLsZ-Avh9_/", "CdLsZ-Avh9_"},
+		{"https://www.instagram.com/zuck/p/CdLsZ-Avh9_/", "CdLsZ-Avh9_"},
+		{"instagram.com/zuck/reel/CdLsZ-Avh9_", "CdLsZ-Avh9_"},
+	}
+	for _, test := range tests {
+		got, err := Shortcode(test.post)
+		if err != nil {
+			t.Errorf("Shortcode(%q): %s", test.post, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("Shortcode(%q) = %q, want %q", test.post, got, test.want)
+		}
+	}
+
+	for _, post := range []string{
+		"",
+		"https://www.instagram.com/zuck/",
+		"https://www.instagram.com/zuck/tagged/",
+		"https://www.instagram.com/p/",
+		"https://www.instagram.com/stories/zuck/3141592653589793238/",
+		"https://example.com/p/CdLsZ-Avh9_/",
+	} {
+		if got, err := Shortcode(post); err == nil {
+			t.Errorf("Shortcode(%q) = %q, want an error", post, got)
+		}
+	}
+}
+
+func TestHashtag(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{"coffee", "coffee"},
+		{"#coffee", "coffee"},
+		{"https://www.instagram.com/explore/tags/coffee/", "coffee"},
+		{"instagram.com/explore/tags/coffee", "coffee"},
+	}
+	for _, test := range tests {
+		got, err := Hashtag(test.tag)
+		if err != nil {
+			t.Errorf("Hashtag(%q): %s", test.tag, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("Hashtag(%q) = %q, want %q", test.tag, got, test.want)
+		}
+	}
+
+	for _, tag := range []string{
+		"",
+		"#",
+		"https://www.instagram.com/explore/tags/",
+		"https://www.instagram.com/explore/locations/213385402/",
+		"https://www.instagram.com/coffee/",
+	} {
+		if got, err := Hashtag(tag); err == nil {
+			t.Errorf("Hashtag(%q) = %q, want an error", tag, got)
+		}
+	}
+}
+
+func TestLocationID(t *testing.T) {
+	tests := []struct {
+		location string
+		want     string
+	}{
+		{"213385402", "213385402"},
+		{"https://www.instagram.com/explore/locations/213385402/", "213385402"},
+		{"https://www.instagram.com/explore/locations/213385402/new-york-new-york/", "213385402"},
+	}
+	for _, test := range tests {
+		got, err := LocationID(test.location)
+		if err != nil {
+			t.Errorf("LocationID(%q): %s", test.location, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("LocationID(%q) = %q, want %q", test.location, got, test.want)
+		}
+	}
+
+	for _, location := range []string{
+		"",
+		"new-york",
+		"https://www.instagram.com/explore/locations/",
+		"https://www.instagram.com/explore/locations/new-york/",
+		"https://www.instagram.com/explore/tags/213385402/",
+	} {
+		if got, err := LocationID(location); err == nil {
+			t.Errorf("LocationID(%q) = %q, want an error", location, got)
+		}
+	}
+}
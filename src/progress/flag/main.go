@@ -0,0 +1,3 @@
+package progress_flag
+
+var Disabled bool // Flag for disabling the progress bar of paginated runs
@@ -0,0 +1,71 @@
+package progress_service
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	progress_flag "github.com/Rfluid/insta-tools/src/progress/flag"
+	"github.com/pterm/pterm"
+	"golang.org/x/term"
+)
+
+// Bar displays the progress of a paginated run on stderr.
+// A nil *Bar is valid and does nothing, so callers never need to check whether the bar is enabled.
+type Bar struct {
+	printer *pterm.ProgressbarPrinter
+	label   string
+	started time.Time
+	pages   int
+}
+
+// Start creates a progress bar for total items, or returns nil when the bar is disabled,
+// the total is unknown or stderr is not a TTY.
+func Start(label string, total int) *Bar {
+	if progress_flag.Disabled || total <= 0 || !term.IsTerminal(int(os.Stderr.Fd())) {
+		return nil
+	}
+
+	printer, err := pterm.DefaultProgressbar.
+		WithTotal(total).
+		WithTitle(label).
+		WithWriter(os.Stderr).
+		WithRemoveWhenDone(true).
+		Start()
+	if err != nil {
+		return nil
+	}
+
+	return &Bar{
+		printer: printer,
+		label:   label,
+		started: time.Now(),
+	}
+}
+
+// Page records a fetched page with the given number of new items.
+func (b *Bar) Page(items int) {
+	if b == nil {
+		return
+	}
+	b.pages++
+
+	// Title first: Add stops the printer once the total is reached
+	current := b.printer.Current + items
+	elapsed := time.Since(b.started).Seconds()
+	rate := float64(current) / max(elapsed, 1e-3)
+	eta := "?"
+	if rate > 0 && current < b.printer.Total {
+		eta = time.Duration(float64(b.printer.Total-current) / rate * float64(time.Second)).Round(time.Second).String()
+	}
+	b.printer.UpdateTitle(fmt.Sprintf("%s | pages: %d | %.1f/s | ETA: %s", b.label, b.pages, rate, eta))
+	b.printer.Add(items)
+}
+
+// Stop removes the bar from the terminal.
+func (b *Bar) Stop() {
+	if b == nil {
+		return
+	}
+	b.printer.Stop()
+}
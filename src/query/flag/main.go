@@ -0,0 +1,3 @@
+package query_flag
+
+var Database string // Path of the SQLite database to query
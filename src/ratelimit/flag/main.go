@@ -0,0 +1,3 @@
+package ratelimit_flag
+
+var RequestsPerMinute int // Maximum number of API requests per minute across all threads, 0 for no limit
@@ -0,0 +1,43 @@
+package ratelimit_service
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	ratelimit_flag "github.com/Rfluid/insta-tools/src/ratelimit/flag"
+)
+
+var (
+	mu   sync.Mutex
+	next time.Time // Earliest time the next request may be sent
+)
+
+// Wait blocks until the next request may be sent under --rate-limit. Every thread of the
+// process shares the same limit, so requests are spaced evenly however many threads run.
+func Wait() {
+	if ratelimit_flag.RequestsPerMinute <= 0 {
+		return
+	}
+	interval := time.Minute / time.Duration(ratelimit_flag.RequestsPerMinute)
+
+	// Reserve the next slot, then wait for it outside of the lock
+	mu.Lock()
+	now := time.Now()
+	slot := next
+	if slot.Before(now) {
+		slot = now
+	}
+	next = slot.Add(interval)
+	mu.Unlock()
+
+	time.Sleep(slot.Sub(now))
+}
+
+// Do sends an Instagram API request once the rate limiter allows it.
+func Do(req *http.Request) (*http.Response, error) {
+	Wait()
+
+	client := &http.Client{}
+	return client.Do(req)
+}
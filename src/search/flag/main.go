@@ -0,0 +1,3 @@
+package search_flag
+
+var Type string // Keep only results of this type: user, hashtag or place
@@ -0,0 +1,94 @@
+package search_service
+
+import (
+	"fmt"
+	"sort"
+
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+)
+
+// Result types
+const (
+	TypeUser    = "user"
+	TypeHashtag = "hashtag"
+	TypePlace   = "place"
+)
+
+// Result is a user, hashtag or place matching a search, ranked as Instagram ranks them.
+type Result struct {
+	Rank       int    `json:"rank"` // Position in the blended results, starting at 1
+	Type       string `json:"type"`
+	ID         string `json:"id"`   // User pk, hashtag ID or location pk
+	Name       string `json:"name"` // Username, hashtag name or place title
+	Detail     string `json:"detail"`
+	IsVerified bool   `json:"is_verified,omitempty"` // Users only
+	IsPrivate  bool   `json:"is_private,omitempty"`  // Users only
+	MediaCount int    `json:"media_count,omitempty"` // Hashtags only
+}
+
+// TableColumns are the fields shown when results are rendered as a table.
+var TableColumns = []string{"rank", "type", "name", "detail", "id"}
+
+// FromResponse converts a raw top search response into results ordered by rank.
+func FromResponse(result map[string]interface{}) []Result {
+	var results []Result
+	for _, entry := range entries(result["users"]) {
+		user, _ := entry["user"].(map[string]interface{})
+		isVerified, _ := user["is_verified"].(bool)
+		isPrivate, _ := user["is_private"].(bool)
+		results = append(results, Result{
+			Rank:       rank(entry),
+			Type:       TypeUser,
+			ID:         value_service.String(user["pk"]),
+			Name:       value_service.String(user["username"]),
+			Detail:     value_service.String(user["full_name"]),
+			IsVerified: isVerified,
+			IsPrivate:  isPrivate,
+		})
+	}
+	for _, entry := range entries(result["hashtags"]) {
+		hashtag, _ := entry["hashtag"].(map[string]interface{})
+		mediaCount := value_service.Int(hashtag["media_count"])
+		results = append(results, Result{
+			Rank:       rank(entry),
+			Type:       TypeHashtag,
+			ID:         value_service.String(hashtag["id"]),
+			Name:       value_service.String(hashtag["name"]),
+			Detail:     fmt.Sprintf("%d posts", mediaCount),
+			MediaCount: mediaCount,
+		})
+	}
+	for _, entry := range entries(result["places"]) {
+		place, _ := entry["place"].(map[string]interface{})
+		location, _ := place["location"].(map[string]interface{})
+		results = append(results, Result{
+			Rank:   rank(entry),
+			Type:   TypePlace,
+			ID:     value_service.String(location["pk"]),
+			Name:   value_service.String(place["title"]),
+			Detail: value_service.String(place["subtitle"]),
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Rank < results[j].Rank
+	})
+	return results
+}
+
+// entries converts a list of results into maps.
+func entries(value interface{}) []map[string]interface{} {
+	list, _ := value.([]interface{})
+	var entries []map[string]interface{}
+	for _, item := range list {
+		if m, ok := item.(map[string]interface{}); ok {
+			entries = append(entries, m)
+		}
+	}
+	return entries
+}
+
+// rank converts the zero-based position of a result into its rank.
+func rank(entry map[string]interface{}) int {
+	return value_service.Int(entry["position"]) + 1
+}
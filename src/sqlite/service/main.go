@@ -0,0 +1,72 @@
+package sqlite_service
+
+import (
+	"database/sql"
+	"fmt"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	"github.com/pterm/pterm"
+	_ "modernc.org/sqlite" // Pure Go driver, registered as "sqlite"
+)
+
+// Directions of a relationship, relative to the source account
+const (
+	DirectionFollowers = "followers" // The user follows the source account
+	DirectionFollowing = "following" // The source account follows the user
+)
+
+// schema is a normalized layout: one row per account in users, one row per
+// (source account, user, direction, fetch) in relationships and one row per (post, user, fetch) in likes.
+const schema = `
+CREATE TABLE IF NOT EXISTS users (
+	pk              TEXT PRIMARY KEY,
+	username        TEXT,
+	full_name       TEXT,
+	is_private      INTEGER,
+	is_verified     INTEGER,
+	profile_pic_url TEXT,
+	follower_count  INTEGER,
+	following_count INTEGER,
+	biography       TEXT,
+	updated_at      TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS relationships (
+	source_pk  TEXT NOT NULL,
+	user_pk    TEXT NOT NULL REFERENCES users (pk),
+	direction  TEXT NOT NULL CHECK (direction IN ('followers', 'following')),
+	fetched_at TEXT NOT NULL,
+	PRIMARY KEY (source_pk, user_pk, direction, fetched_at)
+);
+
+CREATE INDEX IF NOT EXISTS relationships_user_pk ON relationships (user_pk);
+
+CREATE TABLE IF NOT EXISTS likes (
+	media_pk   TEXT NOT NULL,
+	user_pk    TEXT NOT NULL REFERENCES users (pk),
+	fetched_at TEXT NOT NULL,
+	PRIMARY KEY (media_pk, user_pk, fetched_at)
+);
+
+CREATE INDEX IF NOT EXISTS likes_user_pk ON likes (user_pk);
+`
+
+// Open opens (or creates) the SQLite database at path and makes sure the schema exists.
+func Open(path string) (*sql.DB, error) {
+	log_service.LogConditionally(
+		pterm.DefaultLogger.Info,
+		fmt.Sprintf("Opening SQLite database %s", path),
+	)
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create schema: %w", err)
+	}
+
+	return db, nil
+}
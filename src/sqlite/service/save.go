@@ -0,0 +1,181 @@
+package sqlite_service
+
+import (
+	"fmt"
+	"time"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+	"github.com/pterm/pterm"
+)
+
+// Upsert keeps the known value of every column the new row leaves NULL, so storing a follow list
+// does not erase the counts saved from a profile and vice versa
+const upsertUser = `
+INSERT INTO users (pk, username, full_name, is_private, is_verified, profile_pic_url, follower_count, following_count, biography, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (pk) DO UPDATE SET
+	username        = COALESCE(excluded.username, users.username),
+	full_name       = COALESCE(excluded.full_name, users.full_name),
+	is_private      = COALESCE(excluded.is_private, users.is_private),
+	is_verified     = COALESCE(excluded.is_verified, users.is_verified),
+	profile_pic_url = COALESCE(excluded.profile_pic_url, users.profile_pic_url),
+	follower_count  = COALESCE(excluded.follower_count, users.follower_count),
+	following_count = COALESCE(excluded.following_count, users.following_count),
+	biography       = COALESCE(excluded.biography, users.biography),
+	updated_at      = excluded.updated_at
+`
+
+const insertRelationship = `
+INSERT OR IGNORE INTO relationships (source_pk, user_pk, direction, fetched_at)
+VALUES (?, ?, ?, ?)
+`
+
+// SaveRelationships stores the users of a follow list fetched for sourcePK into the database at path.
+// direction is DirectionFollowers or DirectionFollowing.
+func SaveRelationships(path string, sourcePK string, direction string, users []map[string]interface{}) error {
+	db, err := Open(path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	fetchedAt := time.Now().UTC().Format(time.RFC3339)
+	for _, user := range users {
+		pk := value_service.StringOrNil(user["pk"])
+		if pk == nil {
+			continue
+		}
+		if _, err := tx.Exec(upsertUser,
+			pk, value_service.StringOrNil(user["username"]), value_service.StringOrNil(user["full_name"]),
+			flag(user["is_private"]), flag(user["is_verified"]), value_service.StringOrNil(user["profile_pic_url"]),
+			nil, nil, nil, fetchedAt,
+		); err != nil {
+			return fmt.Errorf("failed to save user %v: %w", pk, err)
+		}
+		if _, err := tx.Exec(insertRelationship, sourcePK, pk, direction, fetchedAt); err != nil {
+			return fmt.Errorf("failed to save relationship with user %v: %w", pk, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	log_service.LogConditionally(
+		pterm.DefaultLogger.Info,
+		fmt.Sprintf("Saved %d %s of %s to %s", len(users), direction, sourcePK, path),
+	)
+
+	return nil
+}
+
+const insertLike = `
+INSERT OR IGNORE INTO likes (media_pk, user_pk, fetched_at)
+VALUES (?, ?, ?)
+`
+
+// SaveLikes stores the users that liked the post mediaPK into the database at path.
+func SaveLikes(path string, mediaPK string, users []map[string]interface{}) error {
+	db, err := Open(path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	fetchedAt := time.Now().UTC().Format(time.RFC3339)
+	for _, user := range users {
+		pk := value_service.StringOrNil(user["pk"])
+		if pk == nil {
+			continue
+		}
+		if _, err := tx.Exec(upsertUser,
+			pk, value_service.StringOrNil(user["username"]), value_service.StringOrNil(user["full_name"]),
+			flag(user["is_private"]), flag(user["is_verified"]), value_service.StringOrNil(user["profile_pic_url"]),
+			nil, nil, nil, fetchedAt,
+		); err != nil {
+			return fmt.Errorf("failed to save user %v: %w", pk, err)
+		}
+		if _, err := tx.Exec(insertLike, mediaPK, pk, fetchedAt); err != nil {
+			return fmt.Errorf("failed to save like of user %v: %w", pk, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	log_service.LogConditionally(
+		pterm.DefaultLogger.Info,
+		fmt.Sprintf("Saved %d likers of %s to %s", len(users), mediaPK, path),
+	)
+
+	return nil
+}
+
+// SaveProfiles stores user profiles (the data.user objects returned by user_service.Get) into the database at path.
+func SaveProfiles(path string, profiles []map[string]interface{}) error {
+	db, err := Open(path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	updatedAt := time.Now().UTC().Format(time.RFC3339)
+	for _, profile := range profiles {
+		pk := value_service.StringOrNil(profile["id"])
+		if pk == nil {
+			continue
+		}
+		if _, err := tx.Exec(upsertUser,
+			pk, value_service.StringOrNil(profile["username"]), value_service.StringOrNil(profile["full_name"]),
+			flag(profile["is_private"]), flag(profile["is_verified"]), value_service.StringOrNil(profile["profile_pic_url_hd"]),
+			count(profile, "edge_followed_by"), count(profile, "edge_follow"), value_service.StringOrNil(profile["biography"]),
+			updatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to save user %v: %w", pk, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// flag converts a JSON boolean to a nullable integer column.
+func flag(value interface{}) interface{} {
+	b, ok := value.(bool)
+	if !ok {
+		return nil
+	}
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// count extracts <edge>.count from a profile as a nullable integer column.
+func count(profile map[string]interface{}, edge string) interface{} {
+	edgeData, _ := profile[edge].(map[string]interface{})
+	value, ok := edgeData["count"].(float64)
+	if !ok {
+		return nil
+	}
+	return int64(value)
+}
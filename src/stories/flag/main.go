@@ -0,0 +1,3 @@
+package stories_flag
+
+var Download bool // Archive the story items into download_flag.Directory
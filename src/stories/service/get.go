@@ -0,0 +1,81 @@
+package stories_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	ratelimit_service "github.com/Rfluid/insta-tools/src/ratelimit/service"
+	"github.com/pterm/pterm"
+)
+
+// GetReels makes a request to Instagram's API and returns the items of the given reels as a map[string]interface{}.
+// A reel ID is either a user ID (its current stories) or a highlight ID ("highlight:<id>").
+func GetReels(reelIDs []string, cookies map[string]string) (map[string]interface{}, error) {
+	query := url.Values{}
+	for _, reelID := range reelIDs {
+		query.Add("reel_ids", reelID)
+	}
+	return get("https://www.instagram.com/api/v1/feed/reels_media/", cookies, query)
+}
+
+// GetHighlightsTray makes a request to Instagram's API and returns the highlight reels of a user as a map[string]interface{}
+func GetHighlightsTray(userID string, cookies map[string]string) (map[string]interface{}, error) {
+	// Construct the request URL with user ID
+	url := fmt.Sprintf("https://www.instagram.com/api/v1/highlights/%s/highlights_tray/", userID)
+
+	return get(url, cookies, nil)
+}
+
+func get(url string, cookies map[string]string, query url.Values) (map[string]interface{}, error) {
+	// Build query parameters
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add headers
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	// Add cookies to the request
+	for key, value := range cookies {
+		req.AddCookie(&http.Cookie{Name: key, Value: value})
+	}
+
+	// Add query parameters
+	req.URL.RawQuery = query.Encode()
+
+	// Execute the request
+	resp, err := ratelimit_service.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check if response is successful
+	if resp.StatusCode != http.StatusOK {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Error,
+			fmt.Sprintf("Error fetching stories. API status code is %v", resp.StatusCode),
+		)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return nil, err
+		}
+
+		return result, fmt.Errorf("bad status code (%v) in API response", resp.StatusCode)
+	}
+
+	// Parse the JSON response
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
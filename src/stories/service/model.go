@@ -0,0 +1,42 @@
+package stories_service
+
+import (
+	"time"
+
+	media_service "github.com/Rfluid/insta-tools/src/media/service"
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+)
+
+// Highlight is a highlight reel of an account: stories kept on the profile under a title.
+type Highlight struct {
+	ID        string                `json:"id"`
+	Title     string                `json:"title"`
+	Cover     string                `json:"cover"` // URL of the cover image
+	CreatedAt time.Time             `json:"created_at"`
+	ItemCount int                   `json:"item_count"`
+	Items     []media_service.Media `json:"items"`
+}
+
+// StoryColumns are the fields shown when story items are rendered as a table.
+var StoryColumns = []string{"pk", "type", "taken_at", "url"}
+
+// HighlightColumns are the fields shown when highlights are rendered as a table.
+var HighlightColumns = []string{"title", "item_count", "created_at", "id"}
+
+// highlightFromTray converts an entry of the highlights tray into a Highlight without its items.
+func highlightFromTray(entry map[string]interface{}) Highlight {
+	highlight := Highlight{
+		ID:        value_service.String(entry["id"]),
+		Title:     value_service.String(entry["title"]),
+		ItemCount: value_service.Int(entry["media_count"]),
+	}
+	if createdAt, ok := entry["created_at"].(float64); ok {
+		highlight.CreatedAt = time.Unix(int64(createdAt), 0).UTC()
+	}
+	if cover, ok := entry["cover_media"].(map[string]interface{}); ok {
+		if cropped, ok := cover["cropped_image_version"].(map[string]interface{}); ok {
+			highlight.Cover = value_service.String(cropped["url"])
+		}
+	}
+	return highlight
+}
@@ -0,0 +1,131 @@
+package stories_service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	media_service "github.com/Rfluid/insta-tools/src/media/service"
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+	"github.com/pterm/pterm"
+)
+
+// Highlight reels are requested in batches of this size
+const reelBatchSize = 10
+
+// Stories returns the story items an account currently has up. Accounts without stories have none.
+func Stories(userID string, cookies map[string]string) ([]media_service.Media, error) {
+	log_service.LogConditionally(
+		pterm.DefaultLogger.Info,
+		fmt.Sprintf("Fetching stories of %s", userID),
+	)
+	reels, err := getReels([]string{userID}, cookies)
+	if err != nil {
+		return nil, err
+	}
+	return reels[userID], nil
+}
+
+// Highlights returns the highlight reels of an account with their items.
+// Reels whose items could not be fetched are kept without items and the errors are joined.
+func Highlights(userID string, cookies map[string]string, sleepTime int) ([]Highlight, error) {
+	log_service.LogConditionally(
+		pterm.DefaultLogger.Info,
+		fmt.Sprintf("Fetching highlights of %s", userID),
+	)
+	result, err := GetHighlightsTray(userID, cookies)
+	if err != nil {
+		return nil, err
+	}
+	tray, ok := result["tray"].([]interface{})
+	if !ok {
+		// Invalid response format
+		return nil, fmt.Errorf("invalid response format; missing 'tray' array")
+	}
+
+	var highlights []Highlight
+	for _, entry := range tray {
+		if m, ok := entry.(map[string]interface{}); ok {
+			highlights = append(highlights, highlightFromTray(m))
+		}
+	}
+
+	// Fetch the items of the reels in batches
+	var errs []error
+	for start := 0; start < len(highlights); start += reelBatchSize {
+		if start > 0 {
+			// Optional rate limiting
+			time.Sleep(time.Duration(sleepTime) * time.Second)
+		}
+
+		batch := highlights[start:min(start+reelBatchSize, len(highlights))]
+		ids := make([]string, len(batch))
+		for i, highlight := range batch {
+			ids[i] = highlight.ID
+		}
+
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Info,
+			fmt.Sprintf("Fetching items of %d highlights", len(ids)),
+		)
+		reels, err := getReels(ids, cookies)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for i := range batch {
+			batch[i].Items = reels[batch[i].ID]
+		}
+	}
+
+	return highlights, errors.Join(errs...)
+}
+
+// getReels fetches the items of reels, keyed by reel ID.
+func getReels(reelIDs []string, cookies map[string]string) (map[string][]media_service.Media, error) {
+	result, err := GetReels(reelIDs, cookies)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reels are keyed by ID under "reels"; some API versions list them under "reels_media" instead
+	reels := make(map[string]map[string]interface{})
+	if byID, ok := result["reels"].(map[string]interface{}); ok {
+		for id, reel := range byID {
+			if m, ok := reel.(map[string]interface{}); ok {
+				reels[id] = m
+			}
+		}
+	} else if list, ok := result["reels_media"].([]interface{}); ok {
+		for _, reel := range list {
+			if m, ok := reel.(map[string]interface{}); ok {
+				reels[value_service.String(m["id"])] = m
+			}
+		}
+	}
+
+	items := make(map[string][]media_service.Media)
+	for id, reel := range reels {
+		owner := ""
+		if user, ok := reel["user"].(map[string]interface{}); ok {
+			owner = value_service.String(user["username"])
+		}
+
+		rawItems, _ := reel["items"].([]interface{})
+		media := make([]media_service.Media, 0, len(rawItems))
+		for _, rawItem := range rawItems {
+			item, ok := rawItem.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			story := media_service.FromItem(item)
+			if story.Owner == "" {
+				story.Owner = owner
+			}
+			media = append(media, story)
+		}
+		items[id] = media
+	}
+	return items, nil
+}
@@ -0,0 +1,7 @@
+package user_flag
+
+var (
+	FromFile  string // File with one username per line ("-" for stdin)
+	SleepTime int
+	ID        string // Numeric user ID (pk) to look up instead of a username
+)
@@ -0,0 +1,23 @@
+package user_service
+
+// EdgeCount extracts data.user.<edge>.count from a profile returned by Get.
+// Use "edge_followed_by" for followers and "edge_follow" for following.
+func EdgeCount(profile map[string]interface{}, edge string) (int, bool) {
+	data, ok := profile["data"].(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	user, ok := data["user"].(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	edgeData, ok := user[edge].(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	count, ok := edgeData["count"].(float64)
+	if !ok {
+		return 0, false
+	}
+	return int(count), true
+}
@@ -0,0 +1,49 @@
+package user_service
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// StatusError is returned when the API answers with a non-200 status code.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("bad status code (%v) in API response", e.StatusCode)
+}
+
+// ErrNotFound is returned when the API answers successfully but without a user.
+var ErrNotFound = errors.New("user not found")
+
+// Reasons a lookup failed, as reported by Reason
+const (
+	ReasonNotFound     = "not_found"
+	ReasonRateLimited  = "rate_limited"
+	ReasonUnauthorized = "unauthorized"
+	ReasonFailed       = "failed"
+	ReasonInvalid      = "invalid" // The input line is not a username or profile URL
+)
+
+// Reason classifies a lookup error so failed names can be retried or discarded.
+func Reason(err error) string {
+	if errors.Is(err, ErrNotFound) {
+		return ReasonNotFound
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.StatusCode {
+		case http.StatusNotFound:
+			return ReasonNotFound
+		case http.StatusTooManyRequests:
+			return ReasonRateLimited
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return ReasonUnauthorized
+		}
+	}
+
+	return ReasonFailed
+}
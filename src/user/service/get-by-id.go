@@ -0,0 +1,95 @@
+package user_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	ratelimit_service "github.com/Rfluid/insta-tools/src/ratelimit/service"
+	"github.com/pterm/pterm"
+)
+
+// GetByID fetches Instagram user profile info from the numeric user ID (pk), which unlike the
+// username never changes. The response is converted to the same shape returned by Get.
+func GetByID(userID string, cookies map[string]string) (map[string]interface{}, error) {
+	// Construct the request URL with the user ID
+	url := fmt.Sprintf("https://www.instagram.com/api/v1/users/%s/info/", userID)
+
+	// Create a new request
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add headers to request
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	// Add cookies to the request
+	for key, value := range cookies {
+		req.AddCookie(&http.Cookie{Name: key, Value: value})
+	}
+
+	// Execute the request
+	resp, err := ratelimit_service.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check if response is successful
+	if resp.StatusCode != http.StatusOK {
+		log_service.LogConditionally(
+			pterm.DefaultLogger.Error,
+			fmt.Sprintf("Error fetching user by ID. API status code is %v", resp.StatusCode),
+		)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return nil, err
+		}
+
+		return result, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	// Parse the JSON response
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	user, ok := result["user"].(map[string]interface{})
+	if !ok {
+		return result, ErrNotFound
+	}
+
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"user": fromInfo(user),
+		},
+		"status": result["status"],
+	}, nil
+}
+
+// fromInfo maps a users/{id}/info/ user onto the web_profile_info layout, keeping the original fields.
+func fromInfo(info map[string]interface{}) map[string]interface{} {
+	user := make(map[string]interface{}, len(info)+5)
+	for key, value := range info {
+		user[key] = value
+	}
+
+	user["id"] = info["pk"]
+	if id, ok := info["pk_id"].(string); ok {
+		user["id"] = id
+	}
+	user["edge_followed_by"] = map[string]interface{}{"count": info["follower_count"]}
+	user["edge_follow"] = map[string]interface{}{"count": info["following_count"]}
+	user["edge_owner_to_timeline_media"] = map[string]interface{}{"count": info["media_count"]}
+	if hd, ok := info["hd_profile_pic_url_info"].(map[string]interface{}); ok {
+		user["profile_pic_url_hd"] = hd["url"]
+	}
+
+	return user
+}
@@ -0,0 +1,103 @@
+package user_service
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	"github.com/pterm/pterm"
+)
+
+// LookupError describes a username that could not be fetched by GetMany.
+type LookupError struct {
+	Username string `json:"username"`
+	Reason   string `json:"reason"`
+	Error    string `json:"error"`
+}
+
+// GetMany fetches the profiles (data.user objects) of many usernames concurrently with a pool of
+// threads workers, each waiting sleepTime seconds between requests. Profiles keep the order of
+// usernames; names that failed are reported separately.
+func GetMany(
+	usernames []string,
+	cookies map[string]string,
+	threads int,
+	sleepTime int,
+) ([]map[string]interface{}, []LookupError) {
+	profiles := make([]map[string]interface{}, len(usernames))
+	failures := make([]*LookupError, len(usernames))
+
+	// Channel of tasks, where each task is the index of the username to fetch
+	taskChan := make(chan int)
+
+	var wg sync.WaitGroup // WaitGroup for workers
+
+	worker := func() {
+		defer wg.Done()
+
+		for i := range taskChan {
+			username := usernames[i]
+			log_service.LogConditionally(
+				pterm.DefaultLogger.Info,
+				fmt.Sprintf("Fetching user for %s", username),
+			)
+
+			profile, err := getProfile(username, cookies)
+			if err != nil {
+				failures[i] = &LookupError{
+					Username: username,
+					Reason:   Reason(err),
+					Error:    err.Error(),
+				}
+			} else {
+				profiles[i] = profile
+			}
+
+			// Optional rate limiting
+			time.Sleep(time.Duration(sleepTime) * time.Second)
+		}
+	}
+
+	// Spin up N workers
+	for i := 0; i < max(threads, 1); i++ {
+		wg.Add(1)
+		go worker()
+	}
+
+	for i := range usernames {
+		taskChan <- i
+	}
+	close(taskChan)
+	wg.Wait()
+
+	// Drop the holes left by failed names
+	var (
+		found        []map[string]interface{}
+		lookupErrors []LookupError
+	)
+	for i := range usernames {
+		if failures[i] != nil {
+			lookupErrors = append(lookupErrors, *failures[i])
+		} else {
+			found = append(found, profiles[i])
+		}
+	}
+
+	return found, lookupErrors
+}
+
+// getProfile fetches a single profile and unwraps data.user.
+func getProfile(username string, cookies map[string]string) (map[string]interface{}, error) {
+	result, err := Get(username, cookies)
+	if err != nil {
+		return nil, err
+	}
+
+	data, _ := result["data"].(map[string]interface{})
+	user, ok := data["user"].(map[string]interface{})
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return user, nil
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	log_service "github.com/Rfluid/insta-tools/src/log/service"
+	ratelimit_service "github.com/Rfluid/insta-tools/src/ratelimit/service"
 	"github.com/pterm/pterm"
 )
 
@@ -31,8 +32,7 @@ func Get(username string, cookies map[string]string) (map[string]interface{}, er
 	}
 
 	// Execute the request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := ratelimit_service.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +50,7 @@ func Get(username string, cookies map[string]string) (map[string]interface{}, er
 			return nil, err
 		}
 
-		return result, fmt.Errorf("bad status code (%v) in API response", resp.StatusCode)
+		return result, &StatusError{StatusCode: resp.StatusCode}
 	}
 
 	// Parse the JSON response
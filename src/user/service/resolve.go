@@ -0,0 +1,87 @@
+package user_service
+
+import (
+	"fmt"
+	"strings"
+
+	parser_service "github.com/Rfluid/insta-tools/src/parser/service"
+	value_service "github.com/Rfluid/insta-tools/src/value/service"
+)
+
+// ResolveID returns the numeric user ID of user, which can be given as an ID, a username or a profile URL.
+func ResolveID(user string, cookies map[string]string) (string, error) {
+	user, err := parser_service.Username(user)
+	if err != nil {
+		return "", err
+	}
+	if value_service.IsNumeric(user) {
+		return user, nil
+	}
+
+	profile, err := Get(user, cookies)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the ID of %s: %w", user, err)
+	}
+	data, _ := profile["data"].(map[string]interface{})
+	userData, _ := data["user"].(map[string]interface{})
+	id, ok := userData["id"].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("failed to resolve the ID of %s: %w", user, ErrNotFound)
+	}
+	return id, nil
+}
+
+// Account identifies a user by ID and, when it was looked up, username.
+type Account struct {
+	ID       string `json:"id"`
+	Username string `json:"username,omitempty"`
+}
+
+// ResolveIDs resolves many users, given as IDs, usernames or profile URLs, into accounts.
+// Usernames are looked up concurrently like GetMany; accounts keep the order of users and
+// the ones that could not be resolved are reported separately.
+func ResolveIDs(
+	users []string,
+	cookies map[string]string,
+	threads int,
+	sleepTime int,
+) ([]Account, []LookupError) {
+	var (
+		usernames []string
+		failures  []LookupError
+	)
+	for _, user := range users {
+		username, err := parser_service.Username(user)
+		if err != nil {
+			failures = append(failures, LookupError{Username: user, Reason: ReasonInvalid, Error: err.Error()})
+			continue
+		}
+		if !value_service.IsNumeric(username) {
+			usernames = append(usernames, username)
+		}
+	}
+
+	// Look up the IDs of the usernames
+	profiles, lookupErrors := GetMany(usernames, cookies, threads, sleepTime)
+	failures = append(failures, lookupErrors...)
+	ids := make(map[string]string, len(profiles))
+	for _, profile := range profiles {
+		username, _ := profile["username"].(string)
+		id, _ := profile["id"].(string)
+		ids[strings.ToLower(username)] = id
+	}
+
+	var accounts []Account
+	for _, user := range users {
+		username, err := parser_service.Username(user)
+		if err != nil {
+			continue
+		}
+		if value_service.IsNumeric(username) {
+			accounts = append(accounts, Account{ID: username})
+		} else if id := ids[strings.ToLower(username)]; id != "" {
+			accounts = append(accounts, Account{ID: id, Username: username})
+		}
+	}
+	return accounts, failures
+}
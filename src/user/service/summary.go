@@ -0,0 +1,27 @@
+package user_service
+
+// SummaryColumns are the summary fields shown when batch lookups are rendered as a table.
+var SummaryColumns = []string{"username", "id", "full_name", "is_private", "is_verified", "follower_count", "following_count", "media_count"}
+
+// Summarize flattens a profile (data.user object) into the fields used by batch lookups.
+func Summarize(user map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              user["id"],
+		"username":        user["username"],
+		"full_name":       user["full_name"],
+		"is_private":      user["is_private"],
+		"is_verified":     user["is_verified"],
+		"is_business":     user["is_business_account"],
+		"follower_count":  edgeCount(user, "edge_followed_by"),
+		"following_count": edgeCount(user, "edge_follow"),
+		"media_count":     edgeCount(user, "edge_owner_to_timeline_media"),
+		"biography":       user["biography"],
+		"external_url":    user["external_url"],
+	}
+}
+
+// edgeCount extracts <edge>.count from a data.user object, or nil when missing.
+func edgeCount(user map[string]interface{}, edge string) interface{} {
+	edgeData, _ := user[edge].(map[string]interface{})
+	return edgeData["count"]
+}
@@ -0,0 +1,46 @@
+package value_service
+
+import "strconv"
+
+// String converts a JSON string or number to a string, or "" for any other value.
+// IDs sent as numbers are written in full rather than in exponent notation.
+func String(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+// StringOrNil converts a JSON string or number to a string like String, but returns nil for any
+// other value, e.g. to store missing fields as NULL.
+func StringOrNil(value interface{}) interface{} {
+	switch value.(type) {
+	case string, float64:
+		return String(value)
+	default:
+		return nil
+	}
+}
+
+// Int converts a JSON number to an int, or 0 for any other value.
+func Int(value interface{}) int {
+	v, _ := value.(float64)
+	return int(v)
+}
+
+// IsNumeric reports whether value is a non-empty string of decimal digits, like numeric IDs.
+func IsNumeric(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}